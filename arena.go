@@ -0,0 +1,64 @@
+// Arena allocation of list nodes.
+
+package godll
+
+// Default number of nodes in one arena chunk.
+const defaultChunkSize = 1024
+
+// Arena allocates nodes from contiguous chunks and recycles deleted nodes through a free list.
+type arena[T any] struct {
+	chunkSize int       // Number of nodes allocated at once.
+	chunk     []Node[T] // Remaining unused nodes from current chunk.
+	free      *Node[T]  // Head of free list, linked through next pointers.
+}
+
+// NewListWithArena creates empty List which allocates nodes created with List.NewNode, PushFront and PushBack
+// from contiguous chunks of chunkSize nodes, instead of allocating every node separately.
+// If chunkSize is zero or negative, default chunk size of 1024 nodes is used.
+// Deleted nodes allocated by its arena are recycled, so such node must not be used in any way after it is deleted from List.
+// Nodes created with package-level NewNode, or by arena of other List, are never recycled.
+// Nodes deleted while List has history or transaction are never recycled either, because they can be restored.
+func NewListWithArena[T any](chunkSize int) *List[T] {
+	if chunkSize <= 0 {
+		chunkSize = defaultChunkSize
+	}
+	return &List[T]{arena: &arena[T]{chunkSize: chunkSize}}
+}
+
+// NewNode creates new node with passed value. If List is created with NewListWithArena, node is taken from its arena.
+// Node is not added to List.
+func (l *List[T]) NewNode(value T) *Node[T] {
+	if l.arena == nil {
+		return NewNode(value)
+	}
+	node := l.arena.alloc()
+	node.Value = value
+	return node
+}
+
+// Take node from free list, or from current chunk if free list is empty.
+func (a *arena[T]) alloc() *Node[T] {
+	if a.free != nil {
+		node := a.free
+		a.free = node.next
+		node.next = nil
+		return node
+	}
+
+	if len(a.chunk) == 0 {
+		a.chunk = make([]Node[T], a.chunkSize)
+	}
+	node := &a.chunk[0]
+	node.arena = a
+	a.chunk = a.chunk[1:]
+	return node
+}
+
+// Clear deleted node and put it to free list. Nodes which were not allocated by this arena are left untouched.
+func (a *arena[T]) release(node *Node[T]) {
+	if node.arena != a {
+		return
+	}
+	*node = Node[T]{next: a.free, arena: a}
+	a.free = node
+}
@@ -0,0 +1,222 @@
+package godll
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Create int test list with n nodes allocated from arena.
+func testListIntArena(n, chunkSize int) *List[int] {
+	list := NewListWithArena[int](chunkSize)
+	for i := 1; i <= n; i++ {
+		list.PushBack(i)
+	}
+	return list
+}
+
+func TestNewListWithArena(t *testing.T) {
+	t.Run("Chunk size", func(t *testing.T) {
+		list := NewListWithArena[int](16)
+		assert.Equal(t, 16, list.arena.chunkSize)
+		assert.Equal(t, 0, list.Length())
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Default chunk size", func(t *testing.T) {
+		list := NewListWithArena[int](0)
+		assert.Equal(t, defaultChunkSize, list.arena.chunkSize)
+	})
+}
+
+func TestArenaNewNode(t *testing.T) {
+	t.Run("Without arena", func(t *testing.T) {
+		list := &List[int]{}
+		node := list.NewNode(5)
+		assert.Equal(t, NewNode(5), node)
+	})
+
+	t.Run("Contiguous chunk", func(t *testing.T) {
+		list := NewListWithArena[int](4)
+		for i := 0; i < 4; i++ {
+			var expected *Node[int]
+			if len(list.arena.chunk) > 0 {
+				expected = &list.arena.chunk[0]
+			}
+			node := list.NewNode(i)
+			assert.Equal(t, i, node.Value)
+			assert.Nil(t, node.List())
+			if expected != nil {
+				assert.Same(t, expected, node)
+			}
+		}
+		assert.Empty(t, list.arena.chunk)
+		list.NewNode(4)
+		assert.Len(t, list.arena.chunk, 3)
+	})
+
+	t.Run("Append", func(t *testing.T) {
+		list := NewListWithArena[string](2)
+		for _, v := range []string{"a", "b", "c"} {
+			err := list.Append(list.NewNode(v))
+			assert.Nil(t, err)
+		}
+		forward, backward := listValues(list)
+		assert.Equal(t, []string{"a", "b", "c"}, forward)
+		assert.Equal(t, []string{"a", "b", "c"}, backward)
+		assert.Nil(t, list.Validate())
+	})
+}
+
+func TestArenaRecycle(t *testing.T) {
+	t.Run("PopFront", func(t *testing.T) {
+		list := testListIntArena(3, 4)
+		head := list.head
+		v, ok := list.PopFront()
+		assert.True(t, ok)
+		assert.Equal(t, 1, v)
+		assert.Equal(t, head, list.arena.free)
+		assert.Equal(t, 0, head.Value)
+		assert.Nil(t, head.next)
+
+		node := list.PushBack(7)
+		assert.Same(t, head, node)
+		assert.Nil(t, list.arena.free)
+		forward, backward := listValues(list)
+		assert.Equal(t, []int{2, 3, 7}, forward)
+		assert.Equal(t, []int{2, 3, 7}, backward)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("DeleteValues", func(t *testing.T) {
+		list := NewListWithArena[int](8)
+		for i := 0; i < 6; i++ {
+			list.PushBack(i % 2)
+		}
+		deleted := list.DeleteValues(0, nil)
+		assert.Equal(t, 3, deleted)
+		assert.Nil(t, list.Validate())
+		c := 0
+		for node := list.arena.free; node != nil; node = node.next {
+			c++
+		}
+		assert.Equal(t, 3, c)
+		for i := 0; i < 3; i++ {
+			list.PushFront(5)
+		}
+		assert.Nil(t, list.arena.free)
+		assert.Len(t, list.arena.chunk, 2)
+		forward, _ := listValues(list)
+		assert.Equal(t, []int{5, 5, 5, 1, 1, 1}, forward)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("DeleteAt and DeleteNode", func(t *testing.T) {
+		list := testListIntArena(4, 2)
+		err := list.DeleteAt(1)
+		assert.Nil(t, err)
+		err = list.DeleteNode(list.tail)
+		assert.Nil(t, err)
+		forward, backward := listValues(list)
+		assert.Equal(t, []int{1, 3}, forward)
+		assert.Equal(t, []int{1, 3}, backward)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Foreign node", func(t *testing.T) {
+		list := testListIntArena(2, 4)
+		other := &List[int]{}
+		other.PushBack(5)
+		node := NewNode(7)
+		list.Append(node)
+		err := list.DeleteNode(node)
+		assert.Nil(t, err)
+		assert.Nil(t, list.arena.free)
+		assert.Equal(t, 7, node.Value)
+
+		// Deleted node is not handed out by arena, so it can be safely added to other list.
+		other.Append(node)
+		list.PushBack(3)
+		forward, _ := listValues(other)
+		assert.Equal(t, []int{5, 7}, forward)
+		assert.Nil(t, list.Validate())
+		assert.Nil(t, other.Validate())
+	})
+
+	t.Run("Node of other arena", func(t *testing.T) {
+		list := testListIntArena(2, 4)
+		other := NewListWithArena[int](4)
+		node := other.NewNode(7)
+		list.Append(node)
+		list.Clear()
+		for free := list.arena.free; free != nil; free = free.next {
+			assert.NotSame(t, node, free)
+		}
+		assert.Nil(t, other.arena.free)
+		assert.Equal(t, 7, node.Value)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("SplitAt shares arena", func(t *testing.T) {
+		list := testListIntArena(4, 4)
+		other, err := list.SplitAt(2)
+		assert.Nil(t, err)
+		assert.Equal(t, list.arena, other.arena)
+		assert.Nil(t, list.Validate())
+		assert.Nil(t, other.Validate())
+	})
+}
+
+func BenchmarkAppendArena(b *testing.B) {
+	for _, tc := range benchmarkTestCases {
+		b.Run(tc.name, func(b *testing.B) {
+			b.Run("Heap", func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					list := &List[int]{}
+					for j := 0; j < tc.n; j++ {
+						list.Append(NewNode(j))
+					}
+				}
+			})
+
+			b.Run("Arena", func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					list := NewListWithArena[int](defaultChunkSize)
+					for j := 0; j < tc.n; j++ {
+						list.Append(list.NewNode(j))
+					}
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkDeleteAtArena(b *testing.B) {
+	for _, tc := range benchmarkTestCases {
+		b.Run(tc.name, func(b *testing.B) {
+			b.Run("Heap", func(b *testing.B) {
+				list, _ := testListInt(tc.n)
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					err := list.DeleteAt(0)
+					assert.Nil(b, err)
+					list.Append(NewNode(i))
+				}
+			})
+
+			b.Run("Arena", func(b *testing.B) {
+				list := testListIntArena(tc.n, defaultChunkSize)
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					err := list.DeleteAt(0)
+					assert.Nil(b, err)
+					list.Append(list.NewNode(i))
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,301 @@
+// Recorded changes of doubly linked list.
+
+package godll
+
+// Change of list structure which can be undone and redone.
+// Changes only relink nodes and never allocate or recycle them, so node identities are kept.
+type change[T any] interface {
+	undo(l *List[T])                // Revert change on list which is in state right after change.
+	redo(l *List[T])                // Apply change again on list which is in state right before change.
+	notify(l *List[T], undone bool) // Emit events describing change which was just done, or just undone.
+}
+
+// Report whether changes are passed to recorder or observers. Otherwise changes don't have to be created.
+func (l *List[T]) recording() bool {
+	return l.recorder != nil || len(l.observers) > 0
+}
+
+// Pass change to observers and recorder, if list changes are recorded.
+func (l *List[T]) record(c change[T]) {
+	l.notify(c, false)
+	if l.recorder != nil {
+		l.recorder(c)
+	}
+}
+
+// Emit events of change which was just done or undone, if list has observers.
+func (l *List[T]) notify(c change[T], undone bool) {
+	if len(l.observers) > 0 {
+		c.notify(l, undone)
+	}
+}
+
+// Record all changes made by f as one change.
+func (l *List[T]) recordGroup(f func()) {
+	if l.recorder == nil {
+		f()
+		return
+	}
+
+	outer, discards := l.recorder, l.discards
+	var group groupChange[T]
+	l.recorder = func(c change[T]) {
+		group = append(group, c)
+	}
+	// Record changes even if f panics, so they can be rolled back.
+	// Changes are dropped if f discarded recorded changes, because they can't be undone anymore.
+	defer func() {
+		l.recorder = outer
+		if len(group) > 0 && l.discards == discards {
+			outer(group)
+		}
+	}()
+	f()
+}
+
+// Multiple changes which are undone and redone together.
+type groupChange[T any] []change[T]
+
+func (g groupChange[T]) undo(l *List[T]) {
+	for i := len(g) - 1; i >= 0; i-- {
+		g[i].undo(l)
+	}
+}
+
+func (g groupChange[T]) redo(l *List[T]) {
+	for _, c := range g {
+		c.redo(l)
+	}
+}
+
+// Changes in group emit their events when they are done or undone.
+func (g groupChange[T]) notify(l *List[T], undone bool) {}
+
+// Node was inserted after node after. If after is nil, node was inserted as head.
+type insertChange[T any] struct {
+	node  *Node[T]
+	after *Node[T]
+}
+
+func (c *insertChange[T]) undo(l *List[T]) {
+	l.unlink(c.node)
+	c.node.list = nil
+	c.node.arena = nil
+	l.length--
+	l.notify(c, true)
+}
+
+func (c *insertChange[T]) redo(l *List[T]) {
+	c.node.list = l
+	l.linkAfter(c.node, c.after)
+	l.length++
+	l.notify(c, false)
+}
+
+func (c *insertChange[T]) notify(l *List[T], undone bool) {
+	if undone {
+		l.emit(Event[T]{Kind: EventRemoved, Node: c.node, Index: l.indexAfter(c.after), OldIndex: -1})
+		return
+	}
+	l.emit(Event[T]{Kind: EventInserted, Node: c.node, Index: l.indexOf(c.node), OldIndex: -1})
+}
+
+// Node which was placed after node after was deleted. If after is nil, node was head.
+type deleteChange[T any] struct {
+	node  *Node[T]
+	after *Node[T]
+}
+
+func (c *deleteChange[T]) undo(l *List[T]) {
+	(*insertChange[T])(c).redo(l)
+}
+
+func (c *deleteChange[T]) redo(l *List[T]) {
+	(*insertChange[T])(c).undo(l)
+}
+
+func (c *deleteChange[T]) notify(l *List[T], undone bool) {
+	(*insertChange[T])(c).notify(l, !undone)
+}
+
+// Node placed after node from was moved after node to. Nil from or to means beginning of list.
+type moveChange[T any] struct {
+	node *Node[T]
+	from *Node[T]
+	to   *Node[T]
+}
+
+func (c *moveChange[T]) undo(l *List[T]) {
+	l.unlink(c.node)
+	l.linkAfter(c.node, c.from)
+	l.notify(c, true)
+}
+
+func (c *moveChange[T]) redo(l *List[T]) {
+	l.unlink(c.node)
+	l.linkAfter(c.node, c.to)
+	l.notify(c, false)
+}
+
+func (c *moveChange[T]) notify(l *List[T], undone bool) {
+	// Node was placed after from before move, or after to before move was undone.
+	previous := c.from
+	if undone {
+		previous = c.to
+	}
+
+	index := l.indexOf(c.node)
+	oldIndex := 0
+	if previous != nil {
+		// Previous neighbour was shifted by one, if node was moved in front of it.
+		oldIndex = l.indexOf(previous) + 1
+		if index < oldIndex {
+			oldIndex--
+		}
+	}
+	l.emit(Event[T]{Kind: EventMoved, Node: c.node, Index: index, OldIndex: oldIndex})
+}
+
+// Node first, which was placed before node second, was swapped with it.
+type swapChange[T any] struct {
+	first  *Node[T]
+	second *Node[T]
+}
+
+func (c *swapChange[T]) undo(l *List[T]) {
+	l.swapNodes(c.second, c.first)
+	l.notify(c, true)
+}
+
+func (c *swapChange[T]) redo(l *List[T]) {
+	l.swapNodes(c.first, c.second)
+	l.notify(c, false)
+}
+
+// Swapped nodes took each other's index.
+func (c *swapChange[T]) notify(l *List[T], undone bool) {
+	i, j := l.indexOf(c.first), l.indexOf(c.second)
+	l.emit(Event[T]{Kind: EventMoved, Node: c.first, Index: i, OldIndex: j})
+	l.emit(Event[T]{Kind: EventMoved, Node: c.second, Index: j, OldIndex: i})
+}
+
+// Reorder nodes with f, recording new order as one change and reporting it to observers.
+func (l *List[T]) reorder(f func()) {
+	// Record order of nodes before f, so it can be restored even if f panics.
+	var c *reorderChange[T]
+	if l.recorder != nil {
+		c = &reorderChange[T]{before: l.order()}
+		l.recorder(c)
+	}
+
+	f()
+
+	if c != nil {
+		c.after = l.order()
+	}
+	l.emit(Event[T]{Kind: EventReordered, Index: -1, OldIndex: -1})
+}
+
+// Nodes were reordered from order before to order after.
+type reorderChange[T any] struct {
+	before []*Node[T]
+	after  []*Node[T]
+}
+
+func (c *reorderChange[T]) undo(l *List[T]) {
+	l.relink(c.before)
+	l.notify(c, true)
+}
+
+func (c *reorderChange[T]) redo(l *List[T]) {
+	l.relink(c.after)
+	l.notify(c, false)
+}
+
+func (c *reorderChange[T]) notify(l *List[T], undone bool) {
+	l.emit(Event[T]{Kind: EventReordered, Index: -1, OldIndex: -1})
+}
+
+// All nodes were removed from list.
+type clearChange[T any] struct {
+	nodes []*Node[T]
+}
+
+func (c *clearChange[T]) undo(l *List[T]) {
+	for _, node := range c.nodes {
+		node.list = l
+	}
+	l.relink(c.nodes)
+	l.length = len(c.nodes)
+	l.notify(c, true)
+}
+
+func (c *clearChange[T]) redo(l *List[T]) {
+	l.unlinkAll(false)
+	l.notify(c, false)
+}
+
+// Restored nodes are reported as inserted one by one, from head to tail.
+func (c *clearChange[T]) notify(l *List[T], undone bool) {
+	if !undone {
+		l.emit(Event[T]{Kind: EventCleared, Index: -1, OldIndex: -1})
+		return
+	}
+	for i, node := range c.nodes {
+		l.emit(Event[T]{Kind: EventInserted, Node: node, Index: i, OldIndex: -1})
+	}
+}
+
+// Return node which would be linked into list by undoing or redoing change, but which now belongs to other list.
+// Return nil if change can be safely undone or redone.
+func (l *List[T]) foreignNode(c change[T]) *Node[T] {
+	var nodes []*Node[T]
+	switch c := c.(type) {
+	case groupChange[T]:
+		for _, c := range c {
+			if node := l.foreignNode(c); node != nil {
+				return node
+			}
+		}
+	case *insertChange[T]:
+		nodes = []*Node[T]{c.node}
+	case *deleteChange[T]:
+		nodes = []*Node[T]{c.node}
+	case *clearChange[T]:
+		nodes = c.nodes
+	}
+
+	for _, node := range nodes {
+		if node.list != nil && node.list != l {
+			return node
+		}
+	}
+	return nil
+}
+
+// Return all nodes of list in current order.
+func (l *List[T]) order() []*Node[T] {
+	nodes := make([]*Node[T], 0, l.length)
+	for node := l.head; node != nil; node = node.next {
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// Link nodes owned by list in passed order. All nodes of list must be passed.
+func (l *List[T]) relink(nodes []*Node[T]) {
+	if len(nodes) == 0 {
+		return
+	}
+
+	var previous *Node[T]
+	for _, node := range nodes {
+		node.previous = previous
+		if previous != nil {
+			previous.next = node
+		}
+		previous = node
+	}
+	previous.next = nil
+	l.head, l.tail = nodes[0], previous
+}
@@ -0,0 +1,69 @@
+// Deque operations on doubly linked list.
+
+package godll
+
+// PushFront creates new node with passed value and adds it to the beggining of the List. Return pointer to created node.
+func (l *List[T]) PushFront(value T) *Node[T] {
+	node := l.NewNode(value)
+	// New node is always free, so error means that arena handed out node which is still in use.
+	if err := l.Prepend(node); err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// PushBack creates new node with passed value and adds it to the end of the List. Return pointer to created node.
+func (l *List[T]) PushBack(value T) *Node[T] {
+	node := l.NewNode(value)
+	// New node is always free, so error means that arena handed out node which is still in use.
+	if err := l.Append(node); err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// PopFront deletes first node in List and returns its value. Return zero value and false if List is empty.
+func (l *List[T]) PopFront() (T, bool) {
+	if l.length == 0 {
+		var zero T
+		return zero, false
+	}
+
+	// Read value before deleting, because deleted node can be recycled.
+	value := l.head.Value
+	l.deleteNode(l.head)
+	return value, true
+}
+
+// PopBack deletes last node in List and returns its value. Return zero value and false if List is empty.
+func (l *List[T]) PopBack() (T, bool) {
+	if l.length == 0 {
+		var zero T
+		return zero, false
+	}
+
+	// Read value before deleting, because deleted node can be recycled.
+	value := l.tail.Value
+	l.deleteNode(l.tail)
+	return value, true
+}
+
+// PeekFront returns value of first node in List. Return zero value and false if List is empty.
+func (l *List[T]) PeekFront() (T, bool) {
+	if l.length == 0 {
+		var zero T
+		return zero, false
+	}
+
+	return l.head.Value, true
+}
+
+// PeekBack returns value of last node in List. Return zero value and false if List is empty.
+func (l *List[T]) PeekBack() (T, bool) {
+	if l.length == 0 {
+		var zero T
+		return zero, false
+	}
+
+	return l.tail.Value, true
+}
@@ -0,0 +1,147 @@
+package godll
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPushFront(t *testing.T) {
+	list := &List[int]{}
+	for i := 1; i <= 3; i++ {
+		node := list.PushFront(i)
+		assert.Equal(t, node, list.head)
+		assert.Equal(t, i, node.Value)
+		assert.Equal(t, list, node.List())
+		assert.Equal(t, i, list.length)
+	}
+	forward, backward := listValues(list)
+	assert.Equal(t, []int{3, 2, 1}, forward)
+	assert.Equal(t, []int{3, 2, 1}, backward)
+	assert.Nil(t, list.Validate())
+}
+
+func TestPushBack(t *testing.T) {
+	list := &List[string]{}
+	for _, v := range []string{"a", "b", "c"} {
+		node := list.PushBack(v)
+		assert.Equal(t, node, list.tail)
+		assert.Equal(t, v, node.Value)
+	}
+	forward, backward := listValues(list)
+	assert.Equal(t, []string{"a", "b", "c"}, forward)
+	assert.Equal(t, []string{"a", "b", "c"}, backward)
+	assert.Nil(t, list.Validate())
+}
+
+func TestPushNodeInUse(t *testing.T) {
+	list := NewListWithArena[int](4)
+	node := list.PushBack(1)
+
+	// Corrupt arena, so it hands out node which is still in list.
+	list.arena.free = node
+	assert.PanicsWithError(t, "godll: Prepend: node with value 2 already in list", func() { list.PushFront(2) })
+	list.arena.free = node
+	assert.PanicsWithError(t, "godll: Append: node with value 2 already in list", func() { list.PushBack(2) })
+	assert.Equal(t, 1, list.length)
+}
+
+func TestPopFront(t *testing.T) {
+	t.Run("Empty list", func(t *testing.T) {
+		list := &List[int]{}
+		v, ok := list.PopFront()
+		assert.False(t, ok)
+		assert.Equal(t, 0, v)
+	})
+
+	t.Run("Int", func(t *testing.T) {
+		list, nodes := testListInt(3)
+		for i, node := range nodes {
+			v, ok := list.PopFront()
+			assert.True(t, ok)
+			assert.Equal(t, node.Value, v)
+			assert.Nil(t, node.List())
+			assert.Equal(t, 2-i, list.length)
+			assert.Nil(t, list.Validate())
+		}
+		_, ok := list.PopFront()
+		assert.False(t, ok)
+	})
+
+	t.Run("Struct", func(t *testing.T) {
+		list, nodes := testListStruct(2)
+		v, ok := list.PopFront()
+		assert.True(t, ok)
+		assert.Equal(t, nodes[0].Value, v)
+		assert.Equal(t, nodes[1], list.head)
+		assert.Nil(t, list.Validate())
+	})
+}
+
+func TestPopBack(t *testing.T) {
+	t.Run("Empty list", func(t *testing.T) {
+		list := &List[string]{}
+		v, ok := list.PopBack()
+		assert.False(t, ok)
+		assert.Equal(t, "", v)
+	})
+
+	t.Run("Int", func(t *testing.T) {
+		list, nodes := testListInt(3)
+		for i := len(nodes) - 1; i >= 0; i-- {
+			v, ok := list.PopBack()
+			assert.True(t, ok)
+			assert.Equal(t, nodes[i].Value, v)
+			assert.Nil(t, nodes[i].List())
+			assert.Equal(t, i, list.length)
+			assert.Nil(t, list.Validate())
+		}
+		_, ok := list.PopBack()
+		assert.False(t, ok)
+	})
+}
+
+func TestPeekFront(t *testing.T) {
+	t.Run("Empty list", func(t *testing.T) {
+		list := &List[int]{}
+		v, ok := list.PeekFront()
+		assert.False(t, ok)
+		assert.Equal(t, 0, v)
+	})
+
+	t.Run("Int", func(t *testing.T) {
+		list, nodes := testListInt(3)
+		v, ok := list.PeekFront()
+		assert.True(t, ok)
+		assert.Equal(t, nodes[0].Value, v)
+		assert.Equal(t, 3, list.length)
+	})
+}
+
+func TestPeekBack(t *testing.T) {
+	t.Run("Empty list", func(t *testing.T) {
+		list := &List[int]{}
+		v, ok := list.PeekBack()
+		assert.False(t, ok)
+		assert.Equal(t, 0, v)
+	})
+
+	t.Run("Int", func(t *testing.T) {
+		list, nodes := testListInt(3)
+		v, ok := list.PeekBack()
+		assert.True(t, ok)
+		assert.Equal(t, nodes[2].Value, v)
+		assert.Equal(t, 3, list.length)
+	})
+}
+
+func BenchmarkPopFront(b *testing.B) {
+	for _, tc := range benchmarkTestCases {
+		b.Run(tc.name, func(b *testing.B) {
+			list, _ := testListInt(tc.n)
+			b.ResetTimer()
+			_, ok := list.PopFront()
+			assert.True(b, ok)
+		})
+	}
+}
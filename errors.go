@@ -1,27 +1,107 @@
 package godll
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors which can be matched with errors.Is. Typed errors returned by List match corresponding sentinel error.
+var (
+	ErrIndexOutOfRange   = errors.New("index out of range")
+	ErrNegativeIndex     = errors.New("negative index")
+	ErrNodeNotFound      = errors.New("node not found")
+	ErrNodeAlreadyInList = errors.New("node already in list")
+	ErrInvalidList       = errors.New("invalid list")
+	ErrRollback          = errors.New("rollback failed")
+)
+
+// Format common beginning of error messages with operation name, if it is set.
+func errorPrefix(op string) string {
+	if op == "" {
+		return "godll: "
+	}
+	return "godll: " + op + ": "
+}
 
 type IndexOutOfRangeError struct {
-	Index int
+	Op     string // Name of operation which failed.
+	Index  int    // Requested index.
+	Length int    // Length of list at the time of operation.
 }
 
 func (e *IndexOutOfRangeError) Error() string {
-	return fmt.Sprintf("Index %v is out of range!\n", e.Index)
+	return fmt.Sprintf("%vindex %v out of range for list of length %v", errorPrefix(e.Op), e.Index, e.Length)
+}
+
+func (e *IndexOutOfRangeError) Is(target error) bool {
+	return target == ErrIndexOutOfRange
 }
 
 type NegativeIndexError struct {
-	Index int
+	Op    string // Name of operation which failed.
+	Index int    // Requested index.
 }
 
 func (e *NegativeIndexError) Error() string {
-	return fmt.Sprintf("Index %v is a negative number!\n", e.Index)
+	return fmt.Sprintf("%vindex %v is negative", errorPrefix(e.Op), e.Index)
+}
+
+func (e *NegativeIndexError) Is(target error) bool {
+	return target == ErrNegativeIndex
 }
 
-type NodeNotFoundError[T comparable] struct {
-	Node *Node[T]
+type NodeNotFoundError[T any] struct {
+	Op   string   // Name of operation which failed.
+	Node *Node[T] // Node which was not found.
 }
 
 func (e *NodeNotFoundError[T]) Error() string {
-	return fmt.Sprintf("Node not found: %+v\n", e.Node)
+	if e.Node == nil {
+		return fmt.Sprintf("%vnil node not found", errorPrefix(e.Op))
+	}
+	return fmt.Sprintf("%vnode with value %+v not found", errorPrefix(e.Op), e.Node.Value)
+}
+
+func (e *NodeNotFoundError[T]) Is(target error) bool {
+	return target == ErrNodeNotFound
+}
+
+type NodeAlreadyInListError[T any] struct {
+	Op   string   // Name of operation which failed.
+	Node *Node[T] // Node which already belongs to a list.
+}
+
+func (e *NodeAlreadyInListError[T]) Error() string {
+	if e.Node == nil {
+		return fmt.Sprintf("%vnil node already in list", errorPrefix(e.Op))
+	}
+	return fmt.Sprintf("%vnode with value %+v already in list", errorPrefix(e.Op), e.Node.Value)
+}
+
+func (e *NodeAlreadyInListError[T]) Is(target error) bool {
+	return target == ErrNodeAlreadyInList
+}
+
+type InvalidListError struct {
+	Reason string // Description of broken invariant.
+}
+
+func (e *InvalidListError) Error() string {
+	return fmt.Sprintf("godll: invalid list: %v", e.Reason)
+}
+
+func (e *InvalidListError) Is(target error) bool {
+	return target == ErrInvalidList
+}
+
+type RollbackError struct {
+	Op string // Name of operation which failed.
+}
+
+func (e *RollbackError) Error() string {
+	return fmt.Sprintf("%vchanges can't be rolled back after list was split, spliced or merged", errorPrefix(e.Op))
+}
+
+func (e *RollbackError) Is(target error) bool {
+	return target == ErrRollback
 }
@@ -1,22 +1,81 @@
 package godll
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 )
 
 func TestIndexOutOfRangeError(t *testing.T) {
-	err := &IndexOutOfRangeError{Index: 123}
-	assert.Equal(t, "Index 123 is out of range!\n", err.Error())
+	err := &IndexOutOfRangeError{Op: "GetByIndex", Index: 123, Length: 5}
+	assert.Equal(t, "godll: GetByIndex: index 123 out of range for list of length 5", err.Error())
+	assert.True(t, errors.Is(err, ErrIndexOutOfRange))
+	assert.False(t, errors.Is(err, ErrNegativeIndex))
+
+	err = &IndexOutOfRangeError{Index: 123}
+	assert.Equal(t, "godll: index 123 out of range for list of length 0", err.Error())
 }
 
 func TestNegativeIndexError(t *testing.T) {
-	err := &NegativeIndexError{Index: -123}
-	assert.Equal(t, "Index -123 is a negative number!\n", err.Error())
+	err := &NegativeIndexError{Op: "InsertAt", Index: -123}
+	assert.Equal(t, "godll: InsertAt: index -123 is negative", err.Error())
+	assert.True(t, errors.Is(err, ErrNegativeIndex))
+	assert.False(t, errors.Is(err, ErrIndexOutOfRange))
 }
 
 func TestNodeNotFoundError(t *testing.T) {
-	err := &NodeNotFoundError[int]{Node: NewNode(123)}
-	assert.Equal(t, "Node not found: &{Value:123 next:<nil> previous:<nil>}\n", err.Error())
+	err := &NodeNotFoundError[int]{Op: "DeleteNode", Node: NewNode(123)}
+	assert.Equal(t, "godll: DeleteNode: node with value 123 not found", err.Error())
+	assert.True(t, errors.Is(err, ErrNodeNotFound))
+
+	err = &NodeNotFoundError[int]{Op: "MoveToFront"}
+	assert.Equal(t, "godll: MoveToFront: nil node not found", err.Error())
+}
+
+func TestNodeAlreadyInListError(t *testing.T) {
+	err := &NodeAlreadyInListError[int]{Op: "Append", Node: NewNode(123)}
+	assert.Equal(t, "godll: Append: node with value 123 already in list", err.Error())
+	assert.True(t, errors.Is(err, ErrNodeAlreadyInList))
+	assert.False(t, errors.Is(err, ErrNodeNotFound))
+
+	err = &NodeAlreadyInListError[int]{Op: "Append"}
+	assert.Equal(t, "godll: Append: nil node already in list", err.Error())
+}
+
+func TestInvalidListError(t *testing.T) {
+	err := &InvalidListError{Reason: "tail has next node"}
+	assert.Equal(t, "godll: invalid list: tail has next node", err.Error())
+	assert.True(t, errors.Is(err, ErrInvalidList))
+}
+
+func TestRollbackError(t *testing.T) {
+	err := &RollbackError{Op: "Tx"}
+	assert.Equal(t, "godll: Tx: changes can't be rolled back after list was split, spliced or merged", err.Error())
+	assert.True(t, errors.Is(err, ErrRollback))
+	assert.False(t, errors.Is(err, ErrInvalidList))
+}
+
+func TestErrorsIs(t *testing.T) {
+	t.Run("Returned errors", func(t *testing.T) {
+		list, nodes := testListInt(3)
+		_, err := list.GetByIndex(3)
+		assert.True(t, errors.Is(err, ErrIndexOutOfRange))
+		err = list.DeleteAt(-1)
+		assert.True(t, errors.Is(err, ErrNegativeIndex))
+		err = list.DeleteNode(NewNode(1))
+		assert.True(t, errors.Is(err, ErrNodeNotFound))
+		err = list.Append(nodes[0])
+		assert.True(t, errors.Is(err, ErrNodeAlreadyInList))
+	})
+
+	t.Run("Wrapped", func(t *testing.T) {
+		list, _ := testListInt(3)
+		err := fmt.Errorf("loading page: %w", list.Swap(0, 7))
+		assert.True(t, errors.Is(err, ErrIndexOutOfRange))
+		var rangeErr *IndexOutOfRangeError
+		assert.True(t, errors.As(err, &rangeErr))
+		assert.Equal(t, &IndexOutOfRangeError{Op: "Swap", Index: 7, Length: 3}, rangeErr)
+	})
 }
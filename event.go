@@ -0,0 +1,85 @@
+// Change events of doubly linked list.
+
+package godll
+
+import "slices"
+
+// EventKind describes what kind of change happened to list.
+type EventKind int
+
+const (
+	EventInserted  EventKind = iota // Node was added to list.
+	EventRemoved                    // Node was removed from list.
+	EventMoved                      // Node was moved to other position in list.
+	EventReordered                  // Order of nodes was changed, for example by Sort.
+	EventCleared                    // All nodes were removed from list.
+)
+
+func (k EventKind) String() string {
+	switch k {
+	case EventInserted:
+		return "inserted"
+	case EventRemoved:
+		return "removed"
+	case EventMoved:
+		return "moved"
+	case EventReordered:
+		return "reordered"
+	case EventCleared:
+		return "cleared"
+	}
+	return "unknown"
+}
+
+// Event describes one change of list.
+type Event[T any] struct {
+	Kind     EventKind // Kind of change.
+	Node     *Node[T]  // Changed node, nil for reordered and cleared events.
+	Index    int       // Index of inserted or moved node, or index which removed node had. -1 for reordered and cleared events.
+	OldIndex int       // Index which moved node had before move. -1 for other events.
+}
+
+// Function notified about changes of list.
+type observer[T any] struct {
+	f func(Event[T])
+}
+
+// OnChange registers f to be called after every change of List, when List is already consistent.
+// Changes done by undo, redo and rollback of Tx are reported as well. Return function which unsubscribes f.
+// Computing indexes of changed nodes takes O(n) time, so changes are slower while List has observers.
+// Observer must not change List. If List has arena, node of removed event can be recycled,
+// so it is zeroed once f returns, unless changes are recorded. Copy its value inside f if it is needed later.
+func (l *List[T]) OnChange(f func(Event[T])) (unsubscribe func()) {
+	o := &observer[T]{f: f}
+	l.observers = append(l.observers, o)
+	return func() {
+		// Copy observers, so observers which are being notified at the moment are not affected.
+		l.observers = slices.DeleteFunc(slices.Clone(l.observers), func(other *observer[T]) bool {
+			return other == o
+		})
+	}
+}
+
+// Notify all observers about event.
+func (l *List[T]) emit(e Event[T]) {
+	for _, o := range l.observers {
+		o.f(e)
+	}
+}
+
+// Return index of node which belongs to list.
+func (l *List[T]) indexOf(node *Node[T]) int {
+	i := 0
+	for current := l.head; current != node; current = current.next {
+		i++
+	}
+	return i
+}
+
+// Return index following mark. If mark is nil, index is 0.
+func (l *List[T]) indexAfter(mark *Node[T]) int {
+	if mark == nil {
+		return 0
+	}
+	return l.indexOf(mark) + 1
+}
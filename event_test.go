@@ -0,0 +1,139 @@
+package godll
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Subscribe to list changes and return pointer to slice of received events.
+func recordEvents[T any](list *List[T]) *[]Event[T] {
+	events := &[]Event[T]{}
+	list.OnChange(func(e Event[T]) {
+		// Events are emitted after list is consistent.
+		if err := list.Validate(); err != nil {
+			panic(err)
+		}
+		*events = append(*events, e)
+	})
+	return events
+}
+
+func TestOnChange(t *testing.T) {
+	t.Run("Inserted", func(t *testing.T) {
+		list, nodes := testListInt(3)
+		events := recordEvents(list)
+		node1, node2, node3 := NewNode(4), NewNode(0), NewNode(10)
+		list.Append(node1)
+		list.Prepend(node2)
+		list.InsertAt(2, node3)
+		assert.Equal(t, []Event[int]{
+			{Kind: EventInserted, Node: node1, Index: 3, OldIndex: -1},
+			{Kind: EventInserted, Node: node2, Index: 0, OldIndex: -1},
+			{Kind: EventInserted, Node: node3, Index: 2, OldIndex: -1},
+		}, *events)
+		assert.Equal(t, nodes[0], list.head.next)
+	})
+
+	t.Run("Removed", func(t *testing.T) {
+		list, nodes := testListInt(5)
+		list.Append(NewNode(2))
+		events := recordEvents(list)
+		list.DeleteAt(0)
+		list.DeleteNode(nodes[3])
+		list.DeleteValues(2, nil)
+		assert.Equal(t, []Event[int]{
+			{Kind: EventRemoved, Node: nodes[0], Index: 0, OldIndex: -1},
+			{Kind: EventRemoved, Node: nodes[3], Index: 2, OldIndex: -1},
+			{Kind: EventRemoved, Node: nodes[1], Index: 0, OldIndex: -1},
+			{Kind: EventRemoved, Node: (*events)[3].Node, Index: 2, OldIndex: -1},
+		}, *events)
+	})
+
+	t.Run("Moved", func(t *testing.T) {
+		list, nodes := testListInt(5)
+		events := recordEvents(list)
+		list.MoveToFront(nodes[3])
+		list.MoveToBack(nodes[3])
+		list.MoveBefore(nodes[4], nodes[1])
+		list.MoveAfter(nodes[0], nodes[2])
+		list.Swap(0, 4)
+		assert.Equal(t, []Event[int]{
+			{Kind: EventMoved, Node: nodes[3], Index: 0, OldIndex: 3},
+			{Kind: EventMoved, Node: nodes[3], Index: 4, OldIndex: 0},
+			{Kind: EventMoved, Node: nodes[4], Index: 1, OldIndex: 3},
+			{Kind: EventMoved, Node: nodes[0], Index: 3, OldIndex: 0},
+			{Kind: EventMoved, Node: nodes[4], Index: 4, OldIndex: 0},
+			{Kind: EventMoved, Node: nodes[3], Index: 0, OldIndex: 4},
+		}, *events)
+		forward, _ := listValues(list)
+		assert.Equal(t, []int{4, 2, 3, 1, 5}, forward)
+	})
+
+	t.Run("Reordered and cleared", func(t *testing.T) {
+		list, _ := testListInt(3)
+		events := recordEvents(list)
+		list.Sort(func(v1, v2 int) bool { return v1 > v2 })
+		list.Clear()
+		assert.Equal(t, []Event[int]{
+			{Kind: EventReordered, Index: -1, OldIndex: -1},
+			{Kind: EventCleared, Index: -1, OldIndex: -1},
+		}, *events)
+	})
+
+	t.Run("Split and splice", func(t *testing.T) {
+		list, nodes := testListInt(4)
+		events := recordEvents(list)
+		other, _ := list.SplitAt(2)
+		otherEvents := recordEvents(other)
+		list.Splice(nodes[0], other)
+		assert.Equal(t, []Event[int]{
+			{Kind: EventRemoved, Node: nodes[3], Index: 3, OldIndex: -1},
+			{Kind: EventRemoved, Node: nodes[2], Index: 2, OldIndex: -1},
+			{Kind: EventInserted, Node: nodes[2], Index: 1, OldIndex: -1},
+			{Kind: EventInserted, Node: nodes[3], Index: 2, OldIndex: -1},
+		}, *events)
+		assert.Equal(t, []Event[int]{{Kind: EventCleared, Index: -1, OldIndex: -1}}, *otherEvents)
+	})
+
+	t.Run("Undo and rollback", func(t *testing.T) {
+		list, nodes := testListInt(3)
+		list.EnableHistory(0)
+		events := recordEvents(list)
+		list.MoveToFront(nodes[2])
+		list.Undo()
+		node := NewNode(4)
+		list.Tx(func(tx *Tx[int]) error {
+			tx.Append(node)
+			return errors.New("test error")
+		})
+		assert.Equal(t, []Event[int]{
+			{Kind: EventMoved, Node: nodes[2], Index: 0, OldIndex: 2},
+			{Kind: EventMoved, Node: nodes[2], Index: 2, OldIndex: 0},
+			{Kind: EventInserted, Node: node, Index: 3, OldIndex: -1},
+			{Kind: EventRemoved, Node: node, Index: 3, OldIndex: -1},
+		}, *events)
+	})
+
+	t.Run("Unsubscribe", func(t *testing.T) {
+		list := &List[int]{}
+		calls1, calls2 := 0, 0
+		unsubscribe := list.OnChange(func(e Event[int]) { calls1++ })
+		list.OnChange(func(e Event[int]) { calls2++ })
+		list.PushBack(1)
+		unsubscribe()
+		list.PushBack(2)
+		assert.Equal(t, 1, calls1)
+		assert.Equal(t, 2, calls2)
+	})
+}
+
+func TestEventKindString(t *testing.T) {
+	assert.Equal(t, "inserted", EventInserted.String())
+	assert.Equal(t, "removed", EventRemoved.String())
+	assert.Equal(t, "moved", EventMoved.String())
+	assert.Equal(t, "reordered", EventReordered.String())
+	assert.Equal(t, "cleared", EventCleared.String())
+	assert.Equal(t, "unknown", EventKind(10).String())
+}
@@ -0,0 +1,125 @@
+// Functional operations over doubly linked list.
+
+package godll
+
+import "iter"
+
+// Map returns new List with result of f for value of every node, in the same order.
+func Map[T, U any](l *List[T], f func(T) U) *List[U] {
+	result := &List[U]{}
+	for v := range MapSeq(l, f) {
+		result.PushBack(v)
+	}
+	return result
+}
+
+// MapSeq returns iterator over results of f for value of every node, starting from head. f is called lazily during iteration.
+func MapSeq[T, U any](l *List[T], f func(T) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for v := range l.Values() {
+			if !yield(f(v)) {
+				return
+			}
+		}
+	}
+}
+
+// Filter returns new List with values of nodes for which f returns true, in the same order.
+func Filter[T any](l *List[T], f func(T) bool) *List[T] {
+	result := &List[T]{}
+	for v := range FilterSeq(l, f) {
+		result.PushBack(v)
+	}
+	return result
+}
+
+// FilterSeq returns iterator over values of nodes for which f returns true, starting from head. f is called lazily during iteration.
+func FilterSeq[T any](l *List[T], f func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range l.Values() {
+			if f(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// FlatMap returns new List with all values produced by f for value of every node, in the same order.
+func FlatMap[T, U any](l *List[T], f func(T) iter.Seq[U]) *List[U] {
+	result := &List[U]{}
+	for v := range FlatMapSeq(l, f) {
+		result.PushBack(v)
+	}
+	return result
+}
+
+// FlatMapSeq returns iterator over all values produced by f for value of every node, starting from head.
+// f is called lazily during iteration.
+func FlatMapSeq[T, U any](l *List[T], f func(T) iter.Seq[U]) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for v := range l.Values() {
+			for u := range f(v) {
+				if !yield(u) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Reduce combines values of all nodes, starting from head, into one value.
+// f is called with result of previous call, or with initial for the first node. Return initial if list is empty.
+func Reduce[T, U any](l *List[T], initial U, f func(U, T) U) U {
+	result := initial
+	for v := range l.Values() {
+		result = f(result, v)
+	}
+	return result
+}
+
+// Any reports whether f returns true for value of at least one node. Return false if list is empty.
+func Any[T any](l *List[T], f func(T) bool) bool {
+	_, node := Find(l, f)
+	return node != nil
+}
+
+// All reports whether f returns true for values of all nodes. Return true if list is empty.
+func All[T any](l *List[T], f func(T) bool) bool {
+	for v := range l.Values() {
+		if !f(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// None reports whether f returns false for values of all nodes. Return true if list is empty.
+func None[T any](l *List[T], f func(T) bool) bool {
+	return !Any(l, f)
+}
+
+// Find returns index and first node, starting from head, for whose value f returns true.
+// Returns -1 and nil if there is no such node.
+func Find[T any](l *List[T], f func(T) bool) (int, *Node[T]) {
+	i := 0
+	for current := l.head; current != nil; current = current.next {
+		if f(current.Value) {
+			return i, current
+		}
+		i++
+	}
+	return -1, nil
+}
+
+// FindLast returns index and last node, for whose value f returns true. Nodes are checked starting from tail.
+// Returns -1 and nil if there is no such node.
+func FindLast[T any](l *List[T], f func(T) bool) (int, *Node[T]) {
+	i := l.length - 1
+	for current := l.tail; current != nil; current = current.previous {
+		if f(current.Value) {
+			return i, current
+		}
+		i--
+	}
+	return -1, nil
+}
@@ -0,0 +1,170 @@
+package godll
+
+import (
+	"iter"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func isEven(v int) bool {
+	return v%2 == 0
+}
+
+func TestMap(t *testing.T) {
+	t.Run("Empty list", func(t *testing.T) {
+		result := Map(&List[int]{}, func(v int) int { return v * 2 })
+		assert.Equal(t, 0, result.Length())
+	})
+
+	t.Run("Int", func(t *testing.T) {
+		list, _ := testListInt(3)
+		result := Map(list, func(v int) float64 { return float64(v) / 2 })
+		forward, backward := listValues(result)
+		assert.Equal(t, []float64{0.5, 1, 1.5}, forward)
+		assert.Equal(t, []float64{0.5, 1, 1.5}, backward)
+		assert.Nil(t, result.Validate())
+	})
+
+	t.Run("Struct", func(t *testing.T) {
+		list, _ := testListStruct(2)
+		result := Map(list, func(p PersonTest) string { return p.FirstName })
+		forward, _ := listValues(result)
+		assert.Equal(t, []string{"Bruce1", "Bruce2"}, forward)
+	})
+
+	t.Run("Lazy", func(t *testing.T) {
+		list, _ := testListInt(5)
+		calls := 0
+		for v := range MapSeq(list, func(v int) int { calls++; return v * 10 }) {
+			if v == 20 {
+				break
+			}
+		}
+		assert.Equal(t, 2, calls)
+	})
+}
+
+func TestFilter(t *testing.T) {
+	t.Run("Int", func(t *testing.T) {
+		list, nodes := testListInt(6)
+		result := Filter(list, isEven)
+		forward, backward := listValues(result)
+		assert.Equal(t, []int{2, 4, 6}, forward)
+		assert.Equal(t, []int{2, 4, 6}, backward)
+		assert.NotEqual(t, nodes[1], result.Head())
+		assert.Equal(t, 6, list.Length())
+		assert.Nil(t, result.Validate())
+	})
+
+	t.Run("Struct", func(t *testing.T) {
+		list, _ := testListStruct(3)
+		result := Filter(list, func(p PersonTest) bool { return p.LastName != "Wayne2" })
+		forward, _ := listValues(result)
+		assert.Equal(t, []PersonTest{list.Head().Value, list.Tail().Value}, forward)
+	})
+
+	t.Run("Lazy", func(t *testing.T) {
+		list, _ := testListInt(6)
+		assert.Equal(t, []int{2, 4, 6}, slices.Collect(FilterSeq(list, isEven)))
+		for v := range FilterSeq(list, isEven) {
+			assert.Equal(t, 2, v)
+			break
+		}
+	})
+}
+
+func TestFlatMap(t *testing.T) {
+	t.Run("Int", func(t *testing.T) {
+		list, _ := testListInt(3)
+		result := FlatMap(list, func(v int) iter.Seq[int] {
+			return slices.Values(slices.Repeat([]int{v}, v))
+		})
+		forward, backward := listValues(result)
+		assert.Equal(t, []int{1, 2, 2, 3, 3, 3}, forward)
+		assert.Equal(t, []int{1, 2, 2, 3, 3, 3}, backward)
+		assert.Nil(t, result.Validate())
+	})
+
+	t.Run("Struct", func(t *testing.T) {
+		list, _ := testListStruct(2)
+		result := FlatMap(list, func(p PersonTest) iter.Seq[string] {
+			return slices.Values([]string{p.FirstName, p.LastName})
+		})
+		forward, _ := listValues(result)
+		assert.Equal(t, []string{"Bruce1", "Wayne1", "Bruce2", "Wayne2"}, forward)
+	})
+
+	t.Run("Lazy", func(t *testing.T) {
+		list, _ := testListInt(3)
+		seq := FlatMapSeq(list, func(v int) iter.Seq[string] {
+			return slices.Values(strings.Split(strings.Repeat("x", v), ""))
+		})
+		assert.Equal(t, 6, len(slices.Collect(seq)))
+		for v := range seq {
+			assert.Equal(t, "x", v)
+			break
+		}
+	})
+}
+
+func TestReduce(t *testing.T) {
+	list, _ := testListInt(4)
+	assert.Equal(t, 10, Reduce(list, 0, func(sum, v int) int { return sum + v }))
+	assert.Equal(t, "1234", Reduce(list, "", func(s string, v int) string { return s + string(rune('0'+v)) }))
+	assert.Equal(t, 5, Reduce(&List[int]{}, 5, func(sum, v int) int { return sum + v }))
+
+	people, _ := testListStruct(3)
+	assert.Equal(t, "Bruce1Bruce2Bruce3", Reduce(people, "", func(s string, p PersonTest) string { return s + p.FirstName }))
+}
+
+func TestPredicates(t *testing.T) {
+	list, _ := testListInt(3)
+	empty := &List[int]{}
+	positive := func(v int) bool { return v > 0 }
+	large := func(v int) bool { return v > 10 }
+
+	assert.True(t, Any(list, isEven))
+	assert.False(t, Any(list, large))
+	assert.False(t, Any(empty, isEven))
+
+	assert.True(t, All(list, positive))
+	assert.False(t, All(list, isEven))
+	assert.True(t, All(empty, isEven))
+
+	assert.True(t, None(list, large))
+	assert.False(t, None(list, isEven))
+	assert.True(t, None(empty, isEven))
+}
+
+func TestFind(t *testing.T) {
+	list, nodes := testListInt(5)
+	odd := func(v int) bool { return v%2 == 1 }
+
+	i, node := Find(list, odd)
+	assert.Equal(t, 0, i)
+	assert.Equal(t, nodes[0], node)
+
+	i, node = FindLast(list, odd)
+	assert.Equal(t, 4, i)
+	assert.Equal(t, nodes[4], node)
+
+	i, node = FindLast(list, isEven)
+	assert.Equal(t, 3, i)
+	assert.Equal(t, nodes[3], node)
+
+	i, node = Find(list, func(v int) bool { return v > 10 })
+	assert.Equal(t, -1, i)
+	assert.Nil(t, node)
+
+	i, node = FindLast(list, func(v int) bool { return v > 10 })
+	assert.Equal(t, -1, i)
+	assert.Nil(t, node)
+
+	people, personNodes := testListStruct(3)
+	i, person := Find(people, func(p PersonTest) bool { return p.FirstName == "Bruce2" })
+	assert.Equal(t, 1, i)
+	assert.Equal(t, personNodes[1], person)
+}
@@ -0,0 +1,106 @@
+// Undo and redo history of doubly linked list.
+
+package godll
+
+// History of changes which can be undone and redone.
+type history[T any] struct {
+	depth  int         // Maximum number of undo steps, unlimited if lower than 1.
+	done   []change[T] // Steps which can be undone, last step is the most recent one.
+	undone []change[T] // Steps which can be redone, last step is the most recently undone one.
+}
+
+// Save new undo step. Steps which were undone can't be redone anymore.
+func (h *history[T]) record(c change[T]) {
+	h.done = append(h.done, c)
+	if h.depth > 0 && len(h.done) > h.depth {
+		// Copy steps to the beginning, so the oldest step is not retained by underlying array.
+		n := copy(h.done, h.done[1:])
+		h.done[n] = nil
+		h.done = h.done[:n]
+	}
+	clear(h.undone)
+	h.undone = h.undone[:0]
+}
+
+// EnableHistory starts recording changes of List, so they can be undone with Undo and redone with Redo.
+// Every Append, Prepend, InsertAt, DeleteAt, DeleteNode, DeleteValues, Swap, Sort, move, deque operation
+// and committed Tx is one undo step. At most depth steps are kept, or unlimited number of steps if depth is lower than 1.
+// Any existing history is discarded. Deleted nodes are not recycled by arena while history is enabled.
+// History must not be enabled or disabled inside Tx.
+func (l *List[T]) EnableHistory(depth int) {
+	l.history = &history[T]{depth: depth}
+	l.recorder = l.history.record
+}
+
+// DisableHistory stops recording changes of List and discards history.
+func (l *List[T]) DisableHistory() {
+	l.history = nil
+	l.recorder = nil
+}
+
+// Undo reverts the most recent undo step, restoring exact nodes which were in List.
+// Return false if history is not enabled or there is nothing to undo.
+// Return false and discard history if step would restore node which was meanwhile added to other list.
+func (l *List[T]) Undo() bool {
+	if l.history == nil || len(l.history.done) == 0 {
+		return false
+	}
+
+	h := l.history
+	c := h.done[len(h.done)-1]
+	// Step can't be undone if node which it would link was meanwhile added to other list.
+	if l.foreignNode(c) != nil {
+		l.discardChanges()
+		return false
+	}
+	h.done[len(h.done)-1] = nil
+	h.done = h.done[:len(h.done)-1]
+
+	c.undo(l)
+	h.undone = append(h.undone, c)
+	return true
+}
+
+// Redo applies again the most recently undone step.
+// Return false if history is not enabled or there is nothing to redo.
+// Return false and discard history if step would add node which was meanwhile added to other list.
+func (l *List[T]) Redo() bool {
+	if l.history == nil || len(l.history.undone) == 0 {
+		return false
+	}
+
+	h := l.history
+	c := h.undone[len(h.undone)-1]
+	// Step can't be redone if node which it would link was meanwhile added to other list.
+	if l.foreignNode(c) != nil {
+		l.discardChanges()
+		return false
+	}
+	h.undone[len(h.undone)-1] = nil
+	h.undone = h.undone[:len(h.undone)-1]
+
+	c.redo(l)
+	h.done = append(h.done, c)
+	return true
+}
+
+// Group calls f and records all changes of List made by f as one undo step.
+// If f splits, splices or merges List, history is discarded and changes made by f are not recorded either.
+func (l *List[T]) Group(f func()) {
+	l.recordGroup(f)
+}
+
+// Discard history and changes recorded by active Tx, because List was changed in a way which can't be undone.
+// Changes are recorded only while List has recorder, so there is nothing to discard otherwise.
+func (l *List[T]) discardChanges() {
+	if l.recorder == nil {
+		return
+	}
+	l.discards++
+	if l.history == nil {
+		return
+	}
+	clear(l.history.done)
+	clear(l.history.undone)
+	l.history.done, l.history.undone = l.history.done[:0], l.history.undone[:0]
+}
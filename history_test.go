@@ -0,0 +1,242 @@
+package godll
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistory(t *testing.T) {
+	t.Run("Disabled", func(t *testing.T) {
+		list, _ := testListInt(3)
+		list.DeleteAt(0)
+		assert.False(t, list.Undo())
+		assert.False(t, list.Redo())
+		assert.Equal(t, 2, list.Length())
+	})
+
+	t.Run("Undo and redo every operation", func(t *testing.T) {
+		list, nodes := testListInt(5)
+		list.EnableHistory(0)
+		inserted := NewNode(10)
+
+		operations := []func(){
+			func() { list.Append(NewNode(6)) },
+			func() { list.Prepend(NewNode(0)) },
+			func() { list.InsertAt(3, inserted) },
+			func() { list.DeleteAt(1) },
+			func() { list.DeleteNode(nodes[3]) },
+			func() { list.Append(NewNode(2)) },
+			func() { list.DeleteValues(2, nil) },
+			func() { list.Swap(0, 3) },
+			func() { list.Swap(1, 2) },
+			func() { list.MoveToFront(inserted) },
+			func() { list.PushBack(7) },
+			func() { list.PopFront() },
+			func() { list.Sort(func(v1, v2 int) bool { return v1 > v2 }) },
+		}
+
+		// Save nodes after every operation.
+		states := [][]*Node[int]{listNodes(list)}
+		for _, operation := range operations {
+			operation()
+			assert.Nil(t, list.Validate())
+			states = append(states, listNodes(list))
+		}
+
+		for i := len(states) - 2; i >= 0; i-- {
+			assert.True(t, list.Undo())
+			assert.Equal(t, states[i], listNodes(list))
+			assert.Nil(t, list.Validate())
+		}
+		assert.False(t, list.Undo())
+		assert.Nil(t, inserted.List())
+
+		for i := 1; i < len(states); i++ {
+			assert.True(t, list.Redo())
+			assert.Equal(t, states[i], listNodes(list))
+			assert.Nil(t, list.Validate())
+		}
+		assert.False(t, list.Redo())
+	})
+
+	t.Run("New change clears redo", func(t *testing.T) {
+		list, _ := testListInt(3)
+		list.EnableHistory(0)
+		list.DeleteAt(0)
+		assert.True(t, list.Undo())
+		list.PushBack(4)
+		assert.False(t, list.Redo())
+		forward, _ := listValues(list)
+		assert.Equal(t, []int{1, 2, 3, 4}, forward)
+	})
+
+	t.Run("Depth", func(t *testing.T) {
+		list := &List[int]{}
+		list.EnableHistory(2)
+		for i := 1; i <= 4; i++ {
+			list.PushBack(i)
+		}
+		assert.True(t, list.Undo())
+		assert.True(t, list.Undo())
+		assert.False(t, list.Undo())
+		forward, _ := listValues(list)
+		assert.Equal(t, []int{1, 2}, forward)
+	})
+
+	t.Run("Group", func(t *testing.T) {
+		list, _ := testListInt(3)
+		list.EnableHistory(0)
+		list.Group(func() {
+			list.PushBack(4)
+			list.PushFront(0)
+			list.DeleteAt(2)
+		})
+		forward, _ := listValues(list)
+		assert.Equal(t, []int{0, 1, 3, 4}, forward)
+
+		assert.True(t, list.Undo())
+		forward, backward := listValues(list)
+		assert.Equal(t, []int{1, 2, 3}, forward)
+		assert.Equal(t, []int{1, 2, 3}, backward)
+		assert.False(t, list.Undo())
+
+		assert.True(t, list.Redo())
+		forward, _ = listValues(list)
+		assert.Equal(t, []int{0, 1, 3, 4}, forward)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Tx", func(t *testing.T) {
+		list, _ := testListInt(3)
+		list.EnableHistory(0)
+		list.Tx(func(tx *Tx[int]) error {
+			tx.PushBack(4)
+			tx.PopFront()
+			return nil
+		})
+		list.Tx(func(tx *Tx[int]) error {
+			tx.PushBack(5)
+			return ErrInvalidList
+		})
+		forward, _ := listValues(list)
+		assert.Equal(t, []int{2, 3, 4}, forward)
+
+		assert.True(t, list.Undo())
+		forward, _ = listValues(list)
+		assert.Equal(t, []int{1, 2, 3}, forward)
+		assert.False(t, list.Undo())
+	})
+
+	t.Run("Cleared by split", func(t *testing.T) {
+		list, _ := testListInt(4)
+		list.EnableHistory(0)
+		list.PushBack(5)
+		other, err := list.SplitAt(2)
+		assert.Nil(t, err)
+		assert.False(t, list.Undo())
+
+		list.PushBack(6)
+		assert.Nil(t, list.Splice(nil, other))
+		assert.False(t, list.Undo())
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Node added to other list", func(t *testing.T) {
+		list, nodes := testListInt(3)
+		other := &List[int]{}
+		list.EnableHistory(0)
+		list.PushBack(4)
+		assert.Nil(t, list.DeleteNode(nodes[1]))
+		assert.Nil(t, other.Append(nodes[1]))
+		assert.False(t, list.Undo())
+		assert.False(t, list.Undo())
+		assert.Equal(t, []*Node[int]{nodes[1]}, listNodes(other))
+		forward, _ := listValues(list)
+		assert.Equal(t, []int{1, 3, 4}, forward)
+		assert.Nil(t, list.Validate())
+		assert.Nil(t, other.Validate())
+	})
+
+	t.Run("Undone node added to other list", func(t *testing.T) {
+		list, _ := testListInt(2)
+		other := &List[int]{}
+		list.EnableHistory(0)
+		node := list.PushBack(3)
+		assert.True(t, list.Undo())
+		assert.Nil(t, other.Append(node))
+		assert.False(t, list.Redo())
+		assert.Equal(t, []*Node[int]{node}, listNodes(other))
+		assert.Equal(t, 2, list.Length())
+		assert.Nil(t, list.Validate())
+		assert.Nil(t, other.Validate())
+	})
+
+	t.Run("Cleared node added to other list", func(t *testing.T) {
+		list, nodes := testListInt(3)
+		other := &List[int]{}
+		list.EnableHistory(0)
+		list.Clear()
+		assert.Nil(t, other.Append(nodes[2]))
+		assert.False(t, list.Undo())
+		assert.Equal(t, 0, list.Length())
+		assert.Nil(t, list.Validate())
+		assert.Nil(t, other.Validate())
+	})
+
+	t.Run("Cleared by split in Tx", func(t *testing.T) {
+		list := FromValues(1, 2, 3)
+		list.EnableHistory(0)
+		list.PushBack(4)
+		var other *List[int]
+		assert.Nil(t, list.Tx(func(tx *Tx[int]) error {
+			assert.Nil(t, tx.MoveToFront(list.Tail()))
+			var err error
+			other, err = list.SplitAt(1)
+			return err
+		}))
+		assert.False(t, list.Undo())
+		assert.Equal(t, []int{4}, list.ToSlice())
+		assert.Equal(t, []int{1, 2, 3}, other.ToSlice())
+		assert.Nil(t, list.Validate())
+		assert.Nil(t, other.Validate())
+	})
+
+	t.Run("Cleared by split in Group", func(t *testing.T) {
+		list := FromValues(1, 2, 3)
+		list.EnableHistory(0)
+		var other *List[int]
+		list.Group(func() {
+			list.Reverse()
+			other, _ = list.SplitAt(1)
+		})
+		assert.False(t, list.Undo())
+		assert.Equal(t, []int{3}, list.ToSlice())
+		assert.Nil(t, list.Validate())
+		assert.Nil(t, other.Validate())
+	})
+
+	t.Run("Cleared by merge in Tx", func(t *testing.T) {
+		a, b := FromValues(1, 3), FromValues(2, 4)
+		b.EnableHistory(0)
+		assert.Nil(t, b.Tx(func(tx *Tx[int]) error {
+			assert.Nil(t, tx.Swap(0, 1))
+			assert.Nil(t, tx.Swap(0, 1))
+			MergeSorted(a, b, lessInt)
+			return nil
+		}))
+		assert.False(t, b.Undo())
+		assert.Equal(t, []int{1, 2, 3, 4}, a.ToSlice())
+		assert.Nil(t, a.Validate())
+		assert.Nil(t, b.Validate())
+	})
+
+	t.Run("Disable", func(t *testing.T) {
+		list, _ := testListInt(3)
+		list.EnableHistory(0)
+		list.PushBack(4)
+		list.DisableHistory()
+		assert.False(t, list.Undo())
+		assert.Nil(t, list.recorder)
+	})
+}
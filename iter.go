@@ -0,0 +1,57 @@
+// Iterators over doubly linked list.
+
+package godll
+
+import "iter"
+
+// All returns iterator over index and value pairs of all nodes, starting from head.
+func (l *List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for current := l.head; current != nil; current = current.next {
+			if !yield(i, current.Value) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Backward returns iterator over index and value pairs of all nodes, starting from tail.
+func (l *List[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := l.length - 1
+		for current := l.tail; current != nil; current = current.previous {
+			if !yield(i, current.Value) {
+				return
+			}
+			i--
+		}
+	}
+}
+
+// Values returns iterator over values of all nodes, starting from head.
+func (l *List[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := l.head; current != nil; current = current.next {
+			if !yield(current.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Nodes returns iterator over all nodes, starting from head.
+// Next node is saved before yielding, so yielded node can be safely deleted during iteration.
+func (l *List[T]) Nodes() iter.Seq[*Node[T]] {
+	return func(yield func(*Node[T]) bool) {
+		current := l.head
+		for current != nil {
+			next := current.next
+			if !yield(current) {
+				return
+			}
+			current = next
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package godll
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAll(t *testing.T) {
+	t.Run("Empty list", func(t *testing.T) {
+		list := &List[int]{}
+		for range list.All() {
+			assert.Fail(t, "Empty list should not yield any value")
+		}
+	})
+
+	t.Run("Int", func(t *testing.T) {
+		list, nodes := testListInt(5)
+		c := 0
+		for i, v := range list.All() {
+			assert.Equal(t, c, i)
+			assert.Equal(t, nodes[i].Value, v)
+			c++
+		}
+		assert.Equal(t, 5, c)
+	})
+
+	t.Run("Struct", func(t *testing.T) {
+		list, nodes := testListStruct(3)
+		c := 0
+		for i, v := range list.All() {
+			assert.Equal(t, nodes[i].Value, v)
+			c++
+		}
+		assert.Equal(t, 3, c)
+	})
+
+	t.Run("Break", func(t *testing.T) {
+		list, _ := testListInt(5)
+		c := 0
+		for i := range list.All() {
+			if i == 2 {
+				break
+			}
+			c++
+		}
+		assert.Equal(t, 2, c)
+	})
+
+	t.Run("After Swap", func(t *testing.T) {
+		list, nodes := testListInt(4)
+		err := list.Swap(0, 3)
+		assert.Nil(t, err)
+		expected := []int{nodes[3].Value, nodes[1].Value, nodes[2].Value, nodes[0].Value}
+		for i, v := range list.All() {
+			assert.Equal(t, expected[i], v)
+		}
+	})
+}
+
+func TestBackward(t *testing.T) {
+	t.Run("Empty list", func(t *testing.T) {
+		list := &List[int]{}
+		for range list.Backward() {
+			assert.Fail(t, "Empty list should not yield any value")
+		}
+	})
+
+	t.Run("Int", func(t *testing.T) {
+		list, nodes := testListInt(5)
+		c := 4
+		for i, v := range list.Backward() {
+			assert.Equal(t, c, i)
+			assert.Equal(t, nodes[i].Value, v)
+			c--
+		}
+		assert.Equal(t, -1, c)
+	})
+
+	t.Run("Break", func(t *testing.T) {
+		list, _ := testListInt(5)
+		c := 0
+		for i := range list.Backward() {
+			if i == 2 {
+				break
+			}
+			c++
+		}
+		assert.Equal(t, 2, c)
+	})
+
+	t.Run("After Delete", func(t *testing.T) {
+		list, nodes := testListInt(4)
+		err := list.DeleteAt(3)
+		assert.Nil(t, err)
+		for i, v := range list.Backward() {
+			assert.Equal(t, nodes[i].Value, v)
+		}
+	})
+}
+
+func TestValues(t *testing.T) {
+	t.Run("Empty list", func(t *testing.T) {
+		list := &List[string]{}
+		for range list.Values() {
+			assert.Fail(t, "Empty list should not yield any value")
+		}
+	})
+
+	t.Run("String", func(t *testing.T) {
+		list, nodes := testListString(3)
+		values := []string{}
+		for v := range list.Values() {
+			values = append(values, v)
+		}
+		assert.Equal(t, []string{nodes[0].Value, nodes[1].Value, nodes[2].Value}, values)
+	})
+}
+
+func TestNodes(t *testing.T) {
+	t.Run("Empty list", func(t *testing.T) {
+		list := &List[int]{}
+		for range list.Nodes() {
+			assert.Fail(t, "Empty list should not yield any node")
+		}
+	})
+
+	t.Run("Int", func(t *testing.T) {
+		list, nodes := testListInt(3)
+		i := 0
+		for node := range list.Nodes() {
+			assert.Equal(t, nodes[i], node)
+			i++
+		}
+		assert.Equal(t, 3, i)
+	})
+
+	t.Run("Delete during iteration", func(t *testing.T) {
+		list, nodes := testListInt(5)
+		for node := range list.Nodes() {
+			if node.Value%2 == 0 {
+				err := list.DeleteNode(node)
+				assert.Nil(t, err)
+			}
+		}
+		assert.Equal(t, 3, list.Length())
+		assert.Equal(t, nodes[0], list.Head())
+		assert.Equal(t, nodes[4], list.Tail())
+	})
+}
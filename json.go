@@ -0,0 +1,35 @@
+// JSON encoding of doubly linked list.
+
+package godll
+
+import "encoding/json"
+
+// MarshalJSON encodes List as JSON array of node values, from head to tail.
+// It has value receiver, so List is encoded as array also when it is held by value, for example in struct field.
+func (l List[T]) MarshalJSON() ([]byte, error) {
+	values := make([]T, 0, l.length)
+	for current := l.head; current != nil; current = current.next {
+		values = append(values, current.Value)
+	}
+	return json.Marshal(values)
+}
+
+// UnmarshalJSON decodes JSON array into List. Existing nodes are removed from List and new node is created for every value.
+// List is left unchanged if data is null or can't be decoded.
+func (l *List[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	// Like for Go slices, null leaves List unchanged. Empty array decodes into empty, not nil, slice.
+	if values == nil {
+		return nil
+	}
+
+	// Replacing content is recorded as one change, so it can be undone in one step.
+	l.recordGroup(func() {
+		l.Clear()
+		l.AppendSlice(values)
+	})
+	return nil
+}
@@ -0,0 +1,133 @@
+package godll
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	t.Run("Empty list", func(t *testing.T) {
+		data, err := json.Marshal(&List[int]{})
+		assert.Nil(t, err)
+		assert.Equal(t, "[]", string(data))
+	})
+
+	t.Run("Int", func(t *testing.T) {
+		list, _ := testListInt(3)
+		data, err := json.Marshal(list)
+		assert.Nil(t, err)
+		assert.Equal(t, "[1,2,3]", string(data))
+	})
+
+	t.Run("String", func(t *testing.T) {
+		list, _ := testListString(3)
+		data, err := json.Marshal(list)
+		assert.Nil(t, err)
+		assert.Equal(t, `["1","2","3"]`, string(data))
+	})
+
+	t.Run("Struct", func(t *testing.T) {
+		list, _ := testListStruct(2)
+		data, err := json.Marshal(list)
+		assert.Nil(t, err)
+		assert.Equal(t, `[{"ID":0,"FirstName":"Bruce1","LastName":"Wayne1"},{"ID":0,"FirstName":"Bruce2","LastName":"Wayne2"}]`, string(data))
+	})
+
+	t.Run("Field", func(t *testing.T) {
+		list, _ := testListInt(2)
+		data, err := json.Marshal(struct{ List *List[int] }{List: list})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"List":[1,2]}`, string(data))
+	})
+
+	t.Run("Value field", func(t *testing.T) {
+		list, _ := testListInt(2)
+		data, err := json.Marshal(struct{ List List[int] }{List: *list})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"List":[1,2]}`, string(data))
+	})
+
+	t.Run("Nil list", func(t *testing.T) {
+		data, err := json.Marshal(struct{ List *List[int] }{})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"List":null}`, string(data))
+	})
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	t.Run("Empty array", func(t *testing.T) {
+		list := &List[int]{}
+		err := json.Unmarshal([]byte("[]"), list)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, list.Length())
+		assert.Nil(t, list.head)
+		assert.Nil(t, list.tail)
+	})
+
+	t.Run("Int", func(t *testing.T) {
+		list := &List[int]{}
+		err := json.Unmarshal([]byte("[4,5,6]"), list)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, list.Length())
+		assert.Nil(t, list.head.previous)
+		assert.Nil(t, list.tail.next)
+		expected := []int{4, 5, 6}
+		for i, v := range list.All() {
+			assert.Equal(t, expected[i], v)
+		}
+		for i, v := range list.Backward() {
+			assert.Equal(t, expected[i], v)
+		}
+	})
+
+	t.Run("Struct round trip", func(t *testing.T) {
+		list, nodes := testListStruct(3)
+		data, err := json.Marshal(list)
+		assert.Nil(t, err)
+		decoded := &List[PersonTest]{}
+		err = json.Unmarshal(data, decoded)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, decoded.Length())
+		for node := range decoded.Nodes() {
+			assert.Equal(t, decoded, node.List())
+		}
+		for i, v := range decoded.All() {
+			assert.Equal(t, nodes[i].Value, v)
+		}
+	})
+
+	t.Run("Replace existing", func(t *testing.T) {
+		list, nodes := testListInt(3)
+		err := json.Unmarshal([]byte("[7]"), list)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, list.Length())
+		assert.Equal(t, 7, list.head.Value)
+		assert.Equal(t, list.head, list.tail)
+		for _, node := range nodes {
+			assert.Nil(t, node.List())
+			assert.Nil(t, node.next)
+			assert.Nil(t, node.previous)
+		}
+	})
+
+	t.Run("Null", func(t *testing.T) {
+		list, nodes := testListInt(3)
+		err := json.Unmarshal([]byte("null"), list)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, list.Length())
+		assert.Equal(t, nodes[0], list.head)
+		assert.Equal(t, nodes[2], list.tail)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		list, nodes := testListInt(3)
+		err := json.Unmarshal([]byte(`["a"]`), list)
+		assert.NotNil(t, err)
+		assert.Equal(t, 3, list.Length())
+		assert.Equal(t, nodes[0], list.head)
+		assert.Equal(t, nodes[2], list.tail)
+	})
+}
@@ -8,12 +8,20 @@ import (
 )
 
 // Function used to compare node values.
-type fun[T comparable] func(v1, v2 T) bool
+type fun[T any] func(v1, v2 T) bool
 
-type List[T comparable] struct {
-	head   *Node[T] // Pointer to head (first node in list).
-	tail   *Node[T] // Pointer to tail (last node in list).
-	length int      // Number of nodes in list.
+// List is doubly linked list of nodes holding values of any type.
+type List[T any] struct {
+	head   *Node[T]  // Pointer to head (first node in list).
+	tail   *Node[T]  // Pointer to tail (last node in list).
+	length int       // Number of nodes in list.
+	arena  *arena[T] // Allocator of nodes, nil if nodes are allocated separately.
+
+	recorder func(change[T]) // Function receiving every change of list, nil if changes are not recorded.
+	history  *history[T]     // Undo and redo history, nil if history is not enabled.
+	discards int             // Number of times recorded changes were discarded, so Tx can detect it.
+
+	observers []*observer[T] // Functions notified about every change of list.
 }
 
 // Head returns first node in list.
@@ -44,123 +52,114 @@ func (l *List[T]) Print(w io.Writer) {
 	fmt.Fprintf(w, "%+v\n", l.tail.Value)
 }
 
-func (l *List[T]) validateNegativeIndex(index int) error {
+func validateNegativeIndex(op string, index int) error {
 	// Return error if index is negative number.
 	if index < 0 {
-		return &NegativeIndexError{Index: index}
+		return &NegativeIndexError{Op: op, Index: index}
 	}
 
 	return nil
 }
 
-func (l *List[T]) validateExistingIndex(index int) error {
-	if err := l.validateNegativeIndex(index); err != nil {
+func validateExistingIndex(op string, index, length int) error {
+	if err := validateNegativeIndex(op, index); err != nil {
 		return err
 	}
 
 	// Return error if index is larger than or equal to legth of list.
-	if index >= l.length {
-		return &IndexOutOfRangeError{Index: index}
+	if index >= length {
+		return &IndexOutOfRangeError{Op: op, Index: index, Length: length}
 	}
 
 	return nil
 }
 
-func (l *List[T]) validateInsertableIndex(index int) error {
-	if err := l.validateNegativeIndex(index); err != nil {
+func validateInsertableIndex(op string, index, length int) error {
+	if err := validateNegativeIndex(op, index); err != nil {
 		return err
 	}
 
 	// Return error if index is larger than legth of list.
-	if index > l.length {
-		return &IndexOutOfRangeError{Index: index}
+	if index > length {
+		return &IndexOutOfRangeError{Op: op, Index: index, Length: length}
 	}
 
 	return nil
 }
 
-// Append adds node to the end of the List.
-func (l *List[T]) Append(node *Node[T]) {
-	defer func() { l.length++ }()
-	// Set node as a head and tail if list is empty.
-	if l.length == 0 {
-		l.head = node
-		l.tail = node
-		return
+func (l *List[T]) validateFreeNode(op string, node *Node[T]) error {
+	// Return error if node is nil.
+	if node == nil {
+		return &NodeNotFoundError[T]{Op: op, Node: node}
 	}
 
-	// Add current tail to new variable oldTail. Set passed node as a new tail and connect it to old tail with new next and previous links.
-	oldTail := l.tail
-	l.tail = node
-	node.previous = oldTail
-	oldTail.next = node
+	// Return error if node already belongs to this or any other list.
+	if node.list != nil {
+		return &NodeAlreadyInListError[T]{Op: op, Node: node}
+	}
+
+	return nil
 }
 
-// Prepend adds node to the beggining of the List.
-func (l *List[T]) Prepend(node *Node[T]) {
-	defer func() { l.length++ }()
-	// Set node as a head and tail if list is empty.
-	if l.length == 0 {
-		l.head = node
-		l.tail = node
-		return
+// Append adds node to the end of the List. Return error if node is nil or already belongs to a list.
+func (l *List[T]) Append(node *Node[T]) error {
+	if err := l.validateFreeNode("Append", node); err != nil {
+		return err
 	}
 
-	// Add current head to new variable oldHead. Set passed node as a new head and connect it to old head with new next and previous links.
-	oldHead := l.head
-	l.head = node
-	node.next = oldHead
-	oldHead.previous = node
+	l.insertAfter(node, l.tail)
+	return nil
 }
 
-// InsertAt inserts now node at specific position.
-func (l *List[T]) InsertAt(index int, node *Node[T]) error {
-	if err := l.validateInsertableIndex(index); err != nil {
+// Prepend adds node to the beggining of the List. Return error if node is nil or already belongs to a list.
+func (l *List[T]) Prepend(node *Node[T]) error {
+	if err := l.validateFreeNode("Prepend", node); err != nil {
 		return err
 	}
-	defer func() { l.length++ }()
 
-	// If index is 0 set node as new head of list and connect it to neighbours with new next and previous links.
-	// If length is 0, set node as tail also.
-	if index == 0 {
-		node.next = l.head
-		if l.length != 0 {
-			l.head.previous = node
-		}
-		l.head = node
-		if l.length == 0 {
-			l.tail = node
-		}
-		return nil
-	}
+	l.insertAfter(node, nil)
+	return nil
+}
 
-	// If index is equal to length of list set node as tail of a list and connect it to neighbours with new next and previous links.
-	if index == l.length {
-		node.previous = l.tail
-		l.tail.next = node
-		l.tail = node
-		return nil
+// InsertAt inserts now node at specific position. Return error if node is nil or already belongs to a list.
+func (l *List[T]) InsertAt(index int, node *Node[T]) error {
+	if err := validateInsertableIndex("InsertAt", index, l.length); err != nil {
+		return err
+	}
+	if err := l.validateFreeNode("InsertAt", node); err != nil {
+		return err
 	}
 
-	// Traverse through list to find index, insert new node and connect it to neighbours with new next and previous links.
-	current := l.head
-	for i := 1; i < index; i++ {
-		current = current.next
+	// If index is 0 node becomes new head, otherwise it is inserted after node which is currently at previous index.
+	var mark *Node[T]
+	if index > 0 {
+		mark = l.nodeAt(index - 1)
 	}
-	next := current.next
-	current.next = node
-	node.next = next
-	node.previous = current
-	next.previous = node
+	l.insertAfter(node, mark)
 	return nil
 }
 
+// Add free node to list after mark. If mark is nil, node is added as new head.
+func (l *List[T]) insertAfter(node, mark *Node[T]) {
+	node.list = l
+	l.linkAfter(node, mark)
+	l.length++
+	if l.recording() {
+		l.record(&insertChange[T]{node: node, after: mark})
+	}
+}
+
 // GetByIndex retrieves node by index. Return error if index is out of range. Index of first node is 0.
 func (l *List[T]) GetByIndex(index int) (*Node[T], error) {
-	if err := l.validateExistingIndex(index); err != nil {
+	if err := validateExistingIndex("GetByIndex", index, l.length); err != nil {
 		return nil, err
 	}
 
+	return l.nodeAt(index), nil
+}
+
+// Retrieve node by already validated index.
+func (l *List[T]) nodeAt(index int) *Node[T] {
 	// Calculate index in the middle of the list.
 	m := l.length / 2
 
@@ -170,7 +169,7 @@ func (l *List[T]) GetByIndex(index int) (*Node[T], error) {
 		for i := 0; i < index; i++ {
 			current = current.next
 		}
-		return current, nil
+		return current
 	}
 
 	// If index is closer to tail, start iterating through nodes from tail.
@@ -178,14 +177,21 @@ func (l *List[T]) GetByIndex(index int) (*Node[T], error) {
 	for i := 0; i < (l.length - index - 1); i++ {
 		current = current.previous
 	}
-	return current, nil
+	return current
+}
+
+// Default compare function used when compFunc is nil. Values are compared with "==" as interfaces,
+// so it panics if T is not comparable type, e.g. slice or map.
+func equal[T any](v1, v2 T) bool {
+	return any(v1) == any(v2)
 }
 
 // GetByValue returns index of node and node with passed value using compare function compFunc.
-// If compFunc is nil, use default comparison with "==". Returns -1 and nil if there is no node with given value in List.
+// If compFunc is nil, use default comparison with "==", which panics if T is not comparable.
+// Returns -1 and nil if there is no node with given value in List.
 func (l *List[T]) GetByValue(value T, compFunc fun[T]) (int, *Node[T]) {
 	if compFunc == nil {
-		compFunc = func(v1, v2 T) bool { return v1 == v2 }
+		compFunc = equal[T]
 	}
 	current := l.head
 	for i := 0; i < l.length; i++ {
@@ -198,10 +204,11 @@ func (l *List[T]) GetByValue(value T, compFunc fun[T]) (int, *Node[T]) {
 }
 
 // GetAllValues return map with indexes and nodes of all nodes with passed value using compare function compFunc.
-// If compFunc is nil, use default comparison with "==". Returns empty map if there is no node with given value in List.
+// If compFunc is nil, use default comparison with "==", which panics if T is not comparable.
+// Returns empty map if there is no node with given value in List.
 func (l *List[T]) GetAllValues(value T, compFunc fun[T]) map[int]*Node[T] {
 	if compFunc == nil {
-		compFunc = func(v1, v2 T) bool { return v1 == v2 }
+		compFunc = equal[T]
 	}
 	m := make(map[int]*Node[T], 0)
 
@@ -218,11 +225,11 @@ func (l *List[T]) GetAllValues(value T, compFunc fun[T]) map[int]*Node[T] {
 
 // Swap changes places of nodes on passed positions.
 func (l *List[T]) Swap(i, j int) error {
-	if err := l.validateExistingIndex(i); err != nil {
+	if err := validateExistingIndex("Swap", i, l.length); err != nil {
 		return err
 	}
 
-	if err := l.validateExistingIndex(j); err != nil {
+	if err := validateExistingIndex("Swap", j, l.length); err != nil {
 		return err
 	}
 
@@ -239,23 +246,25 @@ func (l *List[T]) Swap(i, j int) error {
 	}
 
 	// Retrieve nodes with passed indexes.
-	node1, err := l.GetByIndex(i)
-	if err != nil {
-		return err
-	}
-	node2, err := l.GetByIndex(j)
-	if err != nil {
-		return err
+	node1 := l.nodeAt(i)
+	node2 := l.nodeAt(j)
+
+	l.swapNodes(node1, node2)
+	if l.recording() {
+		l.record(&swapChange[T]{first: node1, second: node2})
 	}
 
-	if j-i == 1 {
+	return nil
+}
+
+// Swap nodes, where node1 is placed before node2.
+func (l *List[T]) swapNodes(node1, node2 *Node[T]) {
+	if node1.next == node2 {
 		l.swapNeighbours(node1, node2)
-		return nil
+		return
 	}
 
 	l.swap(node1, node2)
-
-	return nil
 }
 
 func (l *List[T]) swapNeighbours(node1, node2 *Node[T]) {
@@ -330,159 +339,414 @@ func (l *List[T]) swap(node1, node2 *Node[T]) {
 	node2Prev.next = node1
 }
 
+func (l *List[T]) validateOwnNode(op string, node *Node[T]) error {
+	// Return error if node doesn't belong to this list.
+	if node == nil || node.list != l {
+		return &NodeNotFoundError[T]{Op: op, Node: node}
+	}
+
+	return nil
+}
+
+// MoveToFront moves node to the beginning of the List in constant time. Return error if node doesn't belong to list.
+func (l *List[T]) MoveToFront(node *Node[T]) error {
+	if err := l.validateOwnNode("MoveToFront", node); err != nil {
+		return err
+	}
+	if node == l.head {
+		return nil
+	}
+
+	l.move(node, nil)
+	return nil
+}
+
+// MoveToBack moves node to the end of the List in constant time. Return error if node doesn't belong to list.
+func (l *List[T]) MoveToBack(node *Node[T]) error {
+	if err := l.validateOwnNode("MoveToBack", node); err != nil {
+		return err
+	}
+	if node == l.tail {
+		return nil
+	}
+
+	l.move(node, l.tail)
+	return nil
+}
+
+// MoveBefore moves node in front of mark node in constant time. Return error if node or mark doesn't belong to list.
+func (l *List[T]) MoveBefore(node, mark *Node[T]) error {
+	if err := l.validateOwnNode("MoveBefore", node); err != nil {
+		return err
+	}
+	if err := l.validateOwnNode("MoveBefore", mark); err != nil {
+		return err
+	}
+	// Do nothing if node is already in front of mark.
+	if node == mark || mark.previous == node {
+		return nil
+	}
+
+	l.move(node, mark.previous)
+	return nil
+}
+
+// MoveAfter moves node behind mark node in constant time. Return error if node or mark doesn't belong to list.
+func (l *List[T]) MoveAfter(node, mark *Node[T]) error {
+	if err := l.validateOwnNode("MoveAfter", node); err != nil {
+		return err
+	}
+	if err := l.validateOwnNode("MoveAfter", mark); err != nil {
+		return err
+	}
+	// Do nothing if node is already behind mark.
+	if node == mark || mark.next == node {
+		return nil
+	}
+
+	l.move(node, mark)
+	return nil
+}
+
+// SplitAt cuts List in two. Nodes before index stay in List, while node at index and all nodes after it
+// are moved to returned new List. If index is equal to length of List, returned List is empty.
+// Splitting can't be undone, so history of List is discarded.
+func (l *List[T]) SplitAt(index int) (*List[T], error) {
+	if err := validateInsertableIndex("SplitAt", index, l.length); err != nil {
+		return nil, err
+	}
+
+	other := &List[T]{arena: l.arena}
+	if index == l.length {
+		return other, nil
+	}
+	l.discardChanges()
+
+	node := l.nodeAt(index)
+
+	// Move ownership of all nodes from node to tail to new list.
+	other.head, other.tail, other.length = node, l.tail, l.length-index
+	for current := node; current != nil; current = current.next {
+		current.list = other
+	}
+
+	// Cut connection between lists and set new tail of List.
+	l.tail = node.previous
+	if l.tail == nil {
+		l.head = nil
+	} else {
+		l.tail.next = nil
+	}
+	node.previous = nil
+	l.length = index
+
+	// Report moved nodes as removed one by one, from tail to head.
+	if len(l.observers) > 0 {
+		i := l.length + other.length - 1
+		for current := other.tail; current != nil; current = current.previous {
+			l.emit(Event[T]{Kind: EventRemoved, Node: current, Index: i, OldIndex: -1})
+			i--
+		}
+	}
+
+	return other, nil
+}
+
+// Splice moves all nodes from other list after node at. If at is nil, nodes are moved to the beginning of List.
+// Nodes are relinked in constant time, but ownership of every moved node is updated, so Splice takes O(m) time,
+// where m is length of other list. Other list is empty after Splice. Splicing list into itself does nothing.
+// Splicing can't be undone, so history of both lists is discarded. Return error if at doesn't belong to List.
+func (l *List[T]) Splice(at *Node[T], other *List[T]) error {
+	if at != nil {
+		if err := l.validateOwnNode("Splice", at); err != nil {
+			return err
+		}
+	}
+	if other == nil || other == l || other.length == 0 {
+		return nil
+	}
+	l.discardChanges()
+	other.discardChanges()
+
+	for current := other.head; current != nil; current = current.next {
+		current.list = l
+	}
+
+	// Connect head of other list after at, and tail of other list before node which followed at.
+	var next *Node[T]
+	if at == nil {
+		next = l.head
+		l.head = other.head
+	} else {
+		next = at.next
+		at.next = other.head
+	}
+	other.head.previous = at
+
+	if next == nil {
+		l.tail = other.tail
+	} else {
+		next.previous = other.tail
+	}
+	other.tail.next = next
+
+	first, last := other.head, other.tail
+	l.length += other.length
+	other.head, other.tail, other.length = nil, nil, 0
+
+	// Report moved nodes as inserted one by one, from head to tail.
+	if len(l.observers) > 0 {
+		i := l.indexAfter(at)
+		for current := first; current != last.next; current = current.next {
+			l.emit(Event[T]{Kind: EventInserted, Node: current, Index: i, OldIndex: -1})
+			i++
+		}
+	}
+	other.emit(Event[T]{Kind: EventCleared, Index: -1, OldIndex: -1})
+
+	return nil
+}
+
+// Move node owned by list after mark. If mark is nil, node is moved to the beginning of list.
+func (l *List[T]) move(node, mark *Node[T]) {
+	from := node.previous
+	l.unlink(node)
+	l.linkAfter(node, mark)
+	if l.recording() {
+		l.record(&moveChange[T]{node: node, from: from, to: mark})
+	}
+}
+
 // DeleteAt deletes node at given index.
 func (l *List[T]) DeleteAt(index int) error {
-	node, err := l.GetByIndex(index)
-
-	if err != nil {
+	if err := validateExistingIndex("DeleteAt", index, l.length); err != nil {
 		return err
 	}
 
-	l.deleteNode(node)
+	l.deleteNode(l.nodeAt(index))
 
 	return nil
 }
 
-// DeleteNode deletes passed node from list.
+// DeleteNode deletes passed node from list in constant time. Return error if node doesn't belong to list.
 func (l *List[T]) DeleteNode(node *Node[T]) error {
 	if node == nil {
 		return nil
 	}
-	if l.length == 0 {
-		return &NodeNotFoundError[T]{Node: node}
+	if err := l.validateOwnNode("DeleteNode", node); err != nil {
+		return err
 	}
 
-	current := l.head
-	for (current != nil && current.next != nil) || current == l.tail {
-		if current == node {
-			l.deleteNode(node)
-			return nil
-		}
-		current = current.next
-	}
+	l.deleteNode(node)
 
-	return &NodeNotFoundError[T]{Node: node}
+	return nil
 }
 
 // DeleteValues deletes all nodes with passed value using compare function compFunc.
-// If compFunc is nil, use default comparison with "==". Return number of deleted nodes.
+// If compFunc is nil, use default comparison with "==", which panics if T is not comparable.
+// Return number of deleted nodes.
 func (l *List[T]) DeleteValues(value T, compFunc fun[T]) int {
+	if compFunc == nil {
+		compFunc = equal[T]
+	}
+
+	c := 0
+	// Deleting all nodes is recorded as one change, so it can be undone in one step.
+	l.recordGroup(func() {
+		current := l.head
+		for current != nil {
+			// Save next node before deleting, because deleteNode unlinks deleted node.
+			next := current.next
+			if compFunc(current.Value, value) {
+				l.deleteNode(current)
+				c++
+			}
+			current = next
+		}
+	})
+	return c
+}
+
+// Clear deletes all nodes from List and unlinks them, so they can be added to a list again.
+func (l *List[T]) Clear() {
 	if l.length == 0 {
-		return 0
+		return
 	}
 
-	if compFunc == nil {
-		compFunc = func(v1, v2 T) bool { return v1 == v2 }
+	var nodes []*Node[T]
+	if l.recorder != nil {
+		nodes = l.order()
+	}
+	// Recycle nodes only if they can't be restored later.
+	l.unlinkAll(l.recorder == nil)
+	if l.recording() {
+		l.record(&clearChange[T]{nodes: nodes})
 	}
+}
 
-	c := 0
-	current := l.head
-	for (current != nil && current.next != nil) || (current != nil && current == l.tail) {
-		if compFunc(current.Value, value) {
-			l.deleteNode(current)
-			c++
+// Unlink all nodes and clear their ownership. If release is true and List has arena, nodes are recycled.
+// Otherwise nodes can be restored later, so they are detached from arena and never recycled.
+func (l *List[T]) unlinkAll(release bool) {
+	node := l.head
+	for node != nil {
+		next := node.next
+		node.next, node.previous, node.list = nil, nil, nil
+		if !release {
+			node.arena = nil
+		} else if l.arena != nil {
+			l.arena.release(node)
 		}
-		current = current.next
+		node = next
 	}
-	return c
+	l.head, l.tail, l.length = nil, nil, 0
 }
 
-// Delete found node.
+// Delete found node and unlink it, so it can be added to a list again.
+// If List has arena, node is recycled, unless changes are recorded and node may be restored later.
+// Such node is detached from arena, so it isn't recycled even if it is added to other list and deleted from it.
 func (l *List[T]) deleteNode(node *Node[T]) {
-	defer func() { l.length-- }()
-	// If node is both head and tail, it is only node in the list.
-	if node == l.head && node == l.tail {
-		l.head, l.tail = nil, nil
-		return
+	after := node.previous
+	l.unlink(node)
+	node.list = nil
+	l.length--
+	if l.recording() {
+		l.record(&deleteChange[T]{node: node, after: after})
+	}
+	if l.recorder != nil {
+		node.arena = nil
+	} else if l.arena != nil {
+		l.arena.release(node)
 	}
+}
 
-	// If node is head, move head to next node.
+// Disconnect node from its neighbours and update head and tail if needed. Node stays owned by list and length is unchanged.
+func (l *List[T]) unlink(node *Node[T]) {
 	if node == l.head {
 		l.head = node.next
-		l.head.previous = nil
-		return
+	} else {
+		node.previous.next = node.next
 	}
 
-	// If node is tail, move tail to previous node.
 	if node == l.tail {
 		l.tail = node.previous
-		l.tail.next = nil
-		return
+	} else {
+		node.next.previous = node.previous
 	}
 
-	node.next.previous = node.previous
-	node.previous.next = node.next
+	node.next, node.previous = nil, nil
 }
 
-// Sort sorts nodes in List using Merge Sort algorithm with sorting function sortFunc.
-func (l *List[T]) Sort(sortFunc fun[T]) {
-	// Call recursive function to sort list using merge sort algorithm.
-	l.head = sort(l.head, sortFunc)
+// Connect unlinked node after mark and update head and tail if needed. If mark is nil, node is connected as new head.
+func (l *List[T]) linkAfter(node, mark *Node[T]) {
+	var next *Node[T]
+	if mark == nil {
+		next = l.head
+		l.head = node
+	} else {
+		next = mark.next
+		mark.next = node
+	}
 
-	// Iterate through sorted list to find new tail.
-	current := l.head
-	for current != nil && current.next != nil {
-		current = current.next
+	if next == nil {
+		l.tail = node
+	} else {
+		next.previous = node
 	}
-	l.tail = current
+
+	node.previous = mark
+	node.next = next
 }
 
-func sort[T comparable](head *Node[T], sortFunc fun[T]) *Node[T] {
-	// If list is empty or it contains only one node, return passed head.
-	if head == nil || head.next == nil {
-		return head
+// Sort sorts nodes in List using iterative bottom-up Merge Sort algorithm with sorting function sortFunc.
+// sortFunc should return true if v1 must be placed before v2, e.g. "<" for ascending order.
+// Sorting is stable: nodes for which sortFunc returns false in both directions keep their relative order.
+func (l *List[T]) Sort(sortFunc fun[T]) {
+	// If list is empty or it contains only one node, it is already sorted.
+	if l.length < 2 {
+		return
 	}
 
-	// Find middle node in list.
-	middle := split(head, sortFunc)
-
-	// Sort recursively from head and middle node.
-	head = sort(head, sortFunc)
-	middle = sort(middle, sortFunc)
+	l.reorder(func() {
+		// Merge neighbouring sorted runs of width nodes, doubling width until one run covers the whole list.
+		// Only next links are maintained while merging.
+		head := l.head
+		for width := 1; width < l.length; width *= 2 {
+			var newHead, newTail *Node[T]
+			left := head
+			for left != nil {
+				right := cut(left, width)
+				rest := cut(right, width)
+				runHead, runTail := merge(left, right, sortFunc)
+				if newTail == nil {
+					newHead = runHead
+				} else {
+					newTail.next = runHead
+				}
+				newTail = runTail
+				left = rest
+			}
+			head = newHead
+		}
 
-	// Call recursive merge function to merge sorted lists.
-	return merge(head, middle, sortFunc)
+		// Restore previous links and find new tail in a single pass.
+		var previous *Node[T]
+		for current := head; current != nil; current = current.next {
+			current.previous = previous
+			previous = current
+		}
+		l.head, l.tail = head, previous
+	})
 }
 
-func split[T comparable](node *Node[T], sortFunc fun[T]) *Node[T] {
-	// Iterate with one node twice the speed to find middle one.
-	fast, slow := node, node
-	for fast.next != nil && fast.next.next != nil {
-		fast = fast.next.next
-		slow = slow.next
+// Cut first n nodes from run starting with node. Return first node of the remainder.
+func cut[T any](node *Node[T], n int) *Node[T] {
+	for i := 1; node != nil && i < n; i++ {
+		node = node.next
 	}
-	// Set next of middle node as head of second list.
-	middle := slow.next
-	// Set middle node as tail for first list.
-	slow.next = nil
-
-	return middle
+	if node == nil {
+		return nil
+	}
+	rest := node.next
+	node.next = nil
+	return rest
 }
 
-func merge[T comparable](node1, node2 *Node[T], sortFunc fun[T]) *Node[T] {
-	// If one list is empty, return the other one.
-	if node1 == nil {
-		return node2
-	}
-	if node2 == nil {
-		return node1
+// Merge two sorted runs linked by next pointers. Return head and tail of merged run.
+func merge[T any](node1, node2 *Node[T], sortFunc fun[T]) (*Node[T], *Node[T]) {
+	var head, tail *Node[T]
+	for node1 != nil && node2 != nil {
+		// Take node from second run only if it must be placed before node from first run, to keep sorting stable.
+		var node *Node[T]
+		if sortFunc(node2.Value, node1.Value) {
+			node, node2 = node2, node2.next
+		} else {
+			node, node1 = node1, node1.next
+		}
+		if tail == nil {
+			head = node
+		} else {
+			tail.next = node
+		}
+		tail = node
 	}
 
-	// If sorting function returns true exclude head from first list
-	// and merge recursively on remainder of first list and second list.
-	// Return element excluded from list.
-	if sortFunc(node1.Value, node2.Value) {
-		node1.next = merge(node1.next, node2, sortFunc)
-		node1.next.previous = node1
-		node1.previous = nil
-		return node1
+	// Append remainder of the run which is not exhausted and find its tail.
+	rest := node1
+	if rest == nil {
+		rest = node2
+	}
+	if rest == nil {
+		return head, tail
+	}
+	if tail == nil {
+		head = rest
+	} else {
+		tail.next = rest
+	}
+	tail = rest
+	for tail.next != nil {
+		tail = tail.next
 	}
 
-	// If sorting function returns false exclude head from second list
-	// and merge recursively on first list and remainder of second list.
-	// Return element excluded from list.
-	node2.next = merge(node1, node2.next, sortFunc)
-	node2.next.previous = node2
-	node2.previous = nil
-	return node2
+	return head, tail
 }
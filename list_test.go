@@ -22,6 +22,7 @@ func TestHead(t *testing.T) {
 		assert.Equal(t, nodes[1], list.Head())
 		list.Append(nodes[2])
 		assert.Equal(t, nodes[1], list.Head())
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("After Swap", func(t *testing.T) {
@@ -31,12 +32,14 @@ func TestHead(t *testing.T) {
 			err := list.Swap(0, 2)
 			assert.Nil(t, err)
 			assert.Equal(t, nodes[2], list.Head())
+			assert.Nil(t, list.Validate())
 		})
 
 		t.Run("Head unchanged", func(t *testing.T) {
 			err := list.Swap(1, 3)
 			assert.Nil(t, err)
 			assert.Equal(t, nodes[2], list.Head())
+			assert.Nil(t, list.Validate())
 		})
 	})
 
@@ -49,12 +52,14 @@ func TestHead(t *testing.T) {
 			err := list.InsertAt(0, node1)
 			assert.Nil(t, err)
 			assert.Equal(t, node1, list.Head())
+			assert.Nil(t, list.Validate())
 		})
 
 		t.Run("Head unchanged", func(t *testing.T) {
 			err := list.InsertAt(1, node2)
 			assert.Nil(t, err)
 			assert.Equal(t, node1, list.Head())
+			assert.Nil(t, list.Validate())
 		})
 	})
 
@@ -64,6 +69,7 @@ func TestHead(t *testing.T) {
 			err := list.DeleteAt(0)
 			assert.Nil(t, err)
 			assert.Equal(t, nodes[1], list.Head())
+			assert.Nil(t, list.Validate())
 		})
 
 		t.Run("DeleteAt head unchanged", func(t *testing.T) {
@@ -71,6 +77,7 @@ func TestHead(t *testing.T) {
 			err := list.DeleteAt(1)
 			assert.Nil(t, err)
 			assert.Equal(t, nodes[0], list.Head())
+			assert.Nil(t, list.Validate())
 		})
 
 		t.Run("DeleteNode head changed", func(t *testing.T) {
@@ -79,6 +86,7 @@ func TestHead(t *testing.T) {
 			err := list.DeleteNode(nodes[0])
 			assert.Nil(t, err)
 			assert.Equal(t, nodes[1], list.Head())
+			assert.Nil(t, list.Validate())
 		})
 
 		t.Run("DeleteNode head unchanged", func(t *testing.T) {
@@ -87,6 +95,7 @@ func TestHead(t *testing.T) {
 			err := list.DeleteNode(nodes[1])
 			assert.Nil(t, err)
 			assert.Equal(t, nodes[0], list.Head())
+			assert.Nil(t, list.Validate())
 		})
 
 		t.Run("DeleteValues head changed", func(t *testing.T) {
@@ -96,6 +105,7 @@ func TestHead(t *testing.T) {
 			deleted := list.DeleteValues(nodes[0].Value, nil)
 			assert.Equal(t, 2, deleted)
 			assert.Equal(t, nodes[1], list.Head())
+			assert.Nil(t, list.Validate())
 		})
 
 		t.Run("DeleteValues head unchanged", func(t *testing.T) {
@@ -105,6 +115,7 @@ func TestHead(t *testing.T) {
 			deleted := list.DeleteValues(nodes[1].Value, nil)
 			assert.Equal(t, 2, deleted)
 			assert.Equal(t, nodes[0], list.Head())
+			assert.Nil(t, list.Validate())
 		})
 	})
 
@@ -113,12 +124,14 @@ func TestHead(t *testing.T) {
 			list, nodes := testListInt(4)
 			list.Sort(func(v1, v2 int) bool { return v1 > v2 })
 			assert.Equal(t, nodes[3], list.Head())
+			assert.Nil(t, list.Validate())
 		})
 
 		t.Run("Head unchanged", func(t *testing.T) {
 			list, nodes := testListInt(4)
 			list.Sort(func(v1, v2 int) bool { return v1 < v2 })
 			assert.Equal(t, nodes[0], list.Head())
+			assert.Nil(t, list.Validate())
 		})
 	})
 }
@@ -140,6 +153,7 @@ func TestTail(t *testing.T) {
 
 		list.Append(nodes[2])
 		assert.Equal(t, nodes[2], list.Tail())
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("After Swap", func(t *testing.T) {
@@ -149,12 +163,14 @@ func TestTail(t *testing.T) {
 			err := list.Swap(1, 3)
 			assert.Nil(t, err)
 			assert.Equal(t, nodes[1], list.Tail())
+			assert.Nil(t, list.Validate())
 		})
 
 		t.Run("Tail unchanged", func(t *testing.T) {
 			err := list.Swap(0, 2)
 			assert.Nil(t, err)
 			assert.Equal(t, nodes[1], list.Tail())
+			assert.Nil(t, list.Validate())
 		})
 	})
 
@@ -167,12 +183,14 @@ func TestTail(t *testing.T) {
 			err := list.InsertAt(4, node1)
 			assert.Nil(t, err)
 			assert.Equal(t, node1, list.Tail())
+			assert.Nil(t, list.Validate())
 		})
 
 		t.Run("Tail unchanged", func(t *testing.T) {
 			err := list.InsertAt(4, node2)
 			assert.Nil(t, err)
 			assert.Equal(t, node1, list.Tail())
+			assert.Nil(t, list.Validate())
 		})
 	})
 
@@ -182,6 +200,7 @@ func TestTail(t *testing.T) {
 			err := list.DeleteAt(3)
 			assert.Nil(t, err)
 			assert.Equal(t, nodes[2], list.Tail())
+			assert.Nil(t, list.Validate())
 		})
 
 		t.Run("DeleteAt tail unchanged", func(t *testing.T) {
@@ -189,6 +208,7 @@ func TestTail(t *testing.T) {
 			err := list.DeleteAt(2)
 			assert.Nil(t, err)
 			assert.Equal(t, nodes[3], list.Tail())
+			assert.Nil(t, list.Validate())
 		})
 
 		t.Run("DeleteNode tail changed", func(t *testing.T) {
@@ -197,6 +217,7 @@ func TestTail(t *testing.T) {
 			err := list.DeleteNode(nodes[3])
 			assert.Nil(t, err)
 			assert.Equal(t, nodes[2], list.Tail())
+			assert.Nil(t, list.Validate())
 		})
 
 		t.Run("DeleteNode tail unchanged", func(t *testing.T) {
@@ -205,6 +226,7 @@ func TestTail(t *testing.T) {
 			err := list.DeleteNode(nodes[2])
 			assert.Nil(t, err)
 			assert.Equal(t, nodes[3], list.Tail())
+			assert.Nil(t, list.Validate())
 		})
 
 		t.Run("DeleteValues tail changed", func(t *testing.T) {
@@ -214,6 +236,7 @@ func TestTail(t *testing.T) {
 			deleted := list.DeleteValues(nodes[3].Value, nil)
 			assert.Equal(t, 2, deleted)
 			assert.Equal(t, nodes[2], list.Tail())
+			assert.Nil(t, list.Validate())
 		})
 
 		t.Run("DeleteValues tail unchanged", func(t *testing.T) {
@@ -223,6 +246,7 @@ func TestTail(t *testing.T) {
 			deleted := list.DeleteValues(nodes[2].Value, nil)
 			assert.Equal(t, 2, deleted)
 			assert.Equal(t, nodes[3], list.Tail())
+			assert.Nil(t, list.Validate())
 		})
 	})
 	t.Run("After Sort", func(t *testing.T) {
@@ -230,12 +254,14 @@ func TestTail(t *testing.T) {
 			list, nodes := testListInt(4)
 			list.Sort(func(v1, v2 int) bool { return v1 > v2 })
 			assert.Equal(t, nodes[0], list.Tail())
+			assert.Nil(t, list.Validate())
 		})
 
 		t.Run("Tail unchanged", func(t *testing.T) {
 			list, nodes := testListInt(4)
 			list.Sort(func(v1, v2 int) bool { return v1 < v2 })
 			assert.Equal(t, nodes[3], list.Tail())
+			assert.Nil(t, list.Validate())
 		})
 	})
 }
@@ -254,6 +280,7 @@ func TestLength(t *testing.T) {
 
 		list.Prepend(nodes[1])
 		assert.Equal(t, 2, list.Length())
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("After Swap", func(t *testing.T) {
@@ -262,6 +289,7 @@ func TestLength(t *testing.T) {
 		err := list.Swap(1, 3)
 		assert.Nil(t, err)
 		assert.Equal(t, 4, list.Length())
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("After Insert", func(t *testing.T) {
@@ -270,6 +298,7 @@ func TestLength(t *testing.T) {
 		err := list.InsertAt(2, NewNode(123))
 		assert.Nil(t, err)
 		assert.Equal(t, 5, list.Length())
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("After Delete", func(t *testing.T) {
@@ -278,6 +307,7 @@ func TestLength(t *testing.T) {
 			err := list.DeleteAt(1)
 			assert.Nil(t, err)
 			assert.Equal(t, 3, list.Length())
+			assert.Nil(t, list.Validate())
 		})
 
 		t.Run("DeleteNode", func(t *testing.T) {
@@ -286,6 +316,7 @@ func TestLength(t *testing.T) {
 			err := list.DeleteNode(nodes[0])
 			assert.Nil(t, err)
 			assert.Equal(t, 3, list.Length())
+			assert.Nil(t, list.Validate())
 		})
 
 		t.Run("DeleteValues", func(t *testing.T) {
@@ -295,6 +326,7 @@ func TestLength(t *testing.T) {
 			deleted := list.DeleteValues(nodes[3].Value, nil)
 			assert.Equal(t, 2, deleted)
 			assert.Equal(t, 3, list.Length())
+			assert.Nil(t, list.Validate())
 		})
 	})
 	t.Run("After Sort", func(t *testing.T) {
@@ -302,6 +334,7 @@ func TestLength(t *testing.T) {
 			list, _ := testListInt(4)
 			list.Sort(func(v1, v2 int) bool { return v1 > v2 })
 			assert.Equal(t, 4, list.Length())
+			assert.Nil(t, list.Validate())
 		})
 	})
 }
@@ -320,6 +353,7 @@ func TestPrint(t *testing.T) {
 		list.Append(NewNode(4))
 		list.Print(&output)
 		assert.Equal(t, "4\n", output.String())
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Two nodes", func(t *testing.T) {
@@ -327,6 +361,7 @@ func TestPrint(t *testing.T) {
 		list.Append(NewNode(23))
 		list.Print(&output)
 		assert.Equal(t, "4 23\n", output.String())
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Three nodes", func(t *testing.T) {
@@ -334,6 +369,7 @@ func TestPrint(t *testing.T) {
 		list.Append(NewNode(1))
 		list.Print(&output)
 		assert.Equal(t, "4 23 1\n", output.String())
+		assert.Nil(t, list.Validate())
 	})
 }
 
@@ -356,6 +392,7 @@ func TestAppend(t *testing.T) {
 			assert.Equal(t, node, list.tail)
 			assert.Equal(t, node.Value, list.tail.Value)
 		}
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Int", func(t *testing.T) {
@@ -365,6 +402,7 @@ func TestAppend(t *testing.T) {
 			assert.Equal(t, node, list.tail)
 			assert.Equal(t, node.Value, list.tail.Value)
 		}
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("String", func(t *testing.T) {
@@ -374,6 +412,7 @@ func TestAppend(t *testing.T) {
 			assert.Equal(t, node, list.tail)
 			assert.Equal(t, node.Value, list.tail.Value)
 		}
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Struct", func(t *testing.T) {
@@ -385,6 +424,57 @@ func TestAppend(t *testing.T) {
 			assert.Equal(t, node.Value.FirstName, list.tail.Value.FirstName)
 			assert.Equal(t, node.Value.LastName, list.tail.Value.LastName)
 		}
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Already in list", func(t *testing.T) {
+		list, nodes := testListInt(3)
+		err := list.Append(nodes[1])
+		assert.Equal(t, &NodeAlreadyInListError[int]{Op: "Append", Node: nodes[1]}, err)
+		assert.Equal(t, 3, list.length)
+		assert.Equal(t, nodes[2], list.tail)
+		assert.Nil(t, list.tail.next)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Foreign node", func(t *testing.T) {
+		list, _ := testListInt(3)
+		other, otherNodes := testListInt(2)
+		err := list.Append(otherNodes[0])
+		assert.Equal(t, &NodeAlreadyInListError[int]{Op: "Append", Node: otherNodes[0]}, err)
+		assert.Equal(t, 3, list.length)
+		assert.Equal(t, 2, other.length)
+		assert.Equal(t, other, otherNodes[0].list)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Nil node", func(t *testing.T) {
+		list, _ := testListInt(3)
+		err := list.Append(nil)
+		assert.Equal(t, &NodeNotFoundError[int]{Op: "Append"}, err)
+		assert.Equal(t, 3, list.length)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("After delete", func(t *testing.T) {
+		list, nodes := testListInt(3)
+		err := list.DeleteNode(nodes[0])
+		assert.Nil(t, err)
+		err = list.Append(nodes[0])
+		assert.Nil(t, err)
+		assert.Equal(t, nodes[0], list.tail)
+		assert.Equal(t, 3, list.length)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Slice", func(t *testing.T) {
+		list, nodes := &List[[]int]{}, testNodesSlice(5)
+		for _, node := range nodes {
+			list.Append(node)
+			assert.Equal(t, node, list.tail)
+			assert.Equal(t, node.Value, list.tail.Value)
+		}
+		assert.Nil(t, list.Validate())
 	})
 }
 
@@ -405,6 +495,7 @@ func TestPrepend(t *testing.T) {
 			assert.Equal(t, node, list.head)
 			assert.Equal(t, node.Value, list.head.Value)
 		}
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Float64", func(t *testing.T) {
@@ -414,6 +505,7 @@ func TestPrepend(t *testing.T) {
 			assert.Equal(t, node, list.head)
 			assert.Equal(t, node.Value, list.head.Value)
 		}
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("String", func(t *testing.T) {
@@ -423,6 +515,7 @@ func TestPrepend(t *testing.T) {
 			assert.Equal(t, node, list.head)
 			assert.Equal(t, node.Value, list.head.Value)
 		}
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Struct", func(t *testing.T) {
@@ -434,6 +527,36 @@ func TestPrepend(t *testing.T) {
 			assert.Equal(t, node.Value.FirstName, list.head.Value.FirstName)
 			assert.Equal(t, node.Value.LastName, list.head.Value.LastName)
 		}
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Already in list", func(t *testing.T) {
+		list, nodes := testListInt(3)
+		err := list.Prepend(nodes[1])
+		assert.Equal(t, &NodeAlreadyInListError[int]{Op: "Prepend", Node: nodes[1]}, err)
+		assert.Equal(t, 3, list.length)
+		assert.Equal(t, nodes[0], list.head)
+		assert.Nil(t, list.head.previous)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Foreign node", func(t *testing.T) {
+		list, _ := testListInt(3)
+		other, otherNodes := testListInt(2)
+		err := list.Prepend(otherNodes[1])
+		assert.Equal(t, &NodeAlreadyInListError[int]{Op: "Prepend", Node: otherNodes[1]}, err)
+		assert.Equal(t, 3, list.length)
+		assert.Equal(t, 2, other.length)
+		assert.Nil(t, list.Validate())
+		assert.Nil(t, other.Validate())
+	})
+
+	t.Run("Nil node", func(t *testing.T) {
+		list, _ := testListInt(3)
+		err := list.Prepend(nil)
+		assert.Equal(t, &NodeNotFoundError[int]{Op: "Prepend"}, err)
+		assert.Equal(t, 3, list.length)
+		assert.Nil(t, list.Validate())
 	})
 }
 
@@ -453,6 +576,7 @@ func TestInsertAt(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, node, list.head)
 		assert.Equal(t, node, list.tail)
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Beginning", func(t *testing.T) {
@@ -463,6 +587,7 @@ func TestInsertAt(t *testing.T) {
 		assert.Equal(t, newNode, list.head)
 		assert.Nil(t, newNode.previous)
 		assert.Equal(t, nodes[0], newNode.next)
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Middle", func(t *testing.T) {
@@ -472,6 +597,7 @@ func TestInsertAt(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, nodes[0], newNode.previous)
 		assert.Equal(t, nodes[1], newNode.next)
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("End", func(t *testing.T) {
@@ -482,13 +608,14 @@ func TestInsertAt(t *testing.T) {
 		assert.Equal(t, newNode, list.tail)
 		assert.Equal(t, nodes[2], newNode.previous)
 		assert.Nil(t, newNode.next)
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Out of range", func(t *testing.T) {
 		list := &List[int]{}
 		newNode1 := NewNode(12)
 		err := list.InsertAt(1, newNode1)
-		assert.Equal(t, &IndexOutOfRangeError{Index: 1}, err)
+		assert.Equal(t, &IndexOutOfRangeError{Op: "InsertAt", Index: 1, Length: 0}, err)
 		assert.Nil(t, list.head)
 		assert.Nil(t, list.tail)
 		assert.Equal(t, 0, list.length)
@@ -496,27 +623,54 @@ func TestInsertAt(t *testing.T) {
 		list, nodes := testListInt(3)
 		newNode2 := NewNode(12)
 		err = list.InsertAt(4, newNode2)
-		assert.Equal(t, &IndexOutOfRangeError{Index: 4}, err)
+		assert.Equal(t, &IndexOutOfRangeError{Op: "InsertAt", Index: 4, Length: 3}, err)
 		assert.Equal(t, nodes[0], list.head)
 		assert.Equal(t, nodes[2], list.tail)
 		assert.Equal(t, 3, list.length)
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Negative index", func(t *testing.T) {
 		list, _ := testListInt(3)
 		err := list.InsertAt(-1, NewNode(5))
-		assert.Equal(t, &NegativeIndexError{Index: -1}, err)
+		assert.Equal(t, &NegativeIndexError{Op: "InsertAt", Index: -1}, err)
+		assert.Equal(t, 3, list.length)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Already in list", func(t *testing.T) {
+		list, nodes := testListInt(3)
+		err := list.InsertAt(1, nodes[2])
+		assert.Equal(t, &NodeAlreadyInListError[int]{Op: "InsertAt", Node: nodes[2]}, err)
+		assert.Equal(t, 3, list.length)
+		assert.Equal(t, nodes[1], nodes[0].next)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Foreign node", func(t *testing.T) {
+		list, _ := testListInt(3)
+		_, otherNodes := testListInt(2)
+		err := list.InsertAt(1, otherNodes[0])
+		assert.Equal(t, &NodeAlreadyInListError[int]{Op: "InsertAt", Node: otherNodes[0]}, err)
+		assert.Equal(t, 3, list.length)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Nil node", func(t *testing.T) {
+		list, _ := testListInt(3)
+		err := list.InsertAt(1, nil)
+		assert.Equal(t, &NodeNotFoundError[int]{Op: "InsertAt"}, err)
 		assert.Equal(t, 3, list.length)
+		assert.Nil(t, list.Validate())
 	})
 }
 
 func BenchmarkInsertAt(b *testing.B) {
-	node := NewNode(123456789)
 	b.Run("Beginning", func(b *testing.B) {
 		for _, tc := range benchmarkTestCases {
 			list, _ := testListInt(tc.n)
 			b.Run(tc.name, func(b *testing.B) {
-				err := list.InsertAt(0, node)
+				err := list.InsertAt(0, NewNode(123456789))
 				assert.Nil(b, err)
 			})
 		}
@@ -526,7 +680,7 @@ func BenchmarkInsertAt(b *testing.B) {
 		for _, tc := range benchmarkTestCases {
 			list, _ := testListInt(tc.n)
 			b.Run(tc.name, func(b *testing.B) {
-				err := list.InsertAt(tc.n/2, node)
+				err := list.InsertAt(tc.n/2, NewNode(123456789))
 				assert.Nil(b, err)
 			})
 		}
@@ -536,7 +690,7 @@ func BenchmarkInsertAt(b *testing.B) {
 		for _, tc := range benchmarkTestCases {
 			list, _ := testListInt(tc.n)
 			b.Run(tc.name, func(b *testing.B) {
-				err := list.InsertAt(tc.n, node)
+				err := list.InsertAt(tc.n, NewNode(123456789))
 				assert.Nil(b, err)
 			})
 		}
@@ -556,19 +710,19 @@ func TestGetByIndex(t *testing.T) {
 	t.Run("Out of range", func(t *testing.T) {
 		list, _ := testListInt(0)
 		retrieved, err := list.GetByIndex(0)
-		assert.Equal(t, &IndexOutOfRangeError{Index: 0}, err)
+		assert.Equal(t, &IndexOutOfRangeError{Op: "GetByIndex", Index: 0, Length: 0}, err)
 		assert.Nil(t, retrieved)
 
 		list, _ = testListInt(3)
 		retrieved, err = list.GetByIndex(3)
-		assert.Equal(t, &IndexOutOfRangeError{Index: 3}, err)
+		assert.Equal(t, &IndexOutOfRangeError{Op: "GetByIndex", Index: 3, Length: 3}, err)
 		assert.Nil(t, retrieved)
 	})
 
 	t.Run("Negative index", func(t *testing.T) {
 		list, _ := testListInt(3)
 		retrieved, err := list.GetByIndex(-1)
-		assert.Equal(t, &NegativeIndexError{Index: -1}, err)
+		assert.Equal(t, &NegativeIndexError{Op: "GetByIndex", Index: -1}, err)
 		assert.Nil(t, retrieved)
 	})
 }
@@ -721,6 +875,25 @@ func TestGetByValue(t *testing.T) {
 			assert.Nil(t, node)
 		})
 	})
+
+	t.Run("Slice", func(t *testing.T) {
+		t.Run("Custom function", func(t *testing.T) {
+			list, nodes := testListSlice(5)
+			for i, node := range nodes {
+				index, retrieved := list.GetByValue([]int{node.Value[0]}, equalFirst)
+				assert.Equal(t, node, retrieved)
+				assert.Equal(t, i, index)
+			}
+			index, node := list.GetByValue([]int{123}, equalFirst)
+			assert.Equal(t, -1, index)
+			assert.Nil(t, node)
+		})
+
+		t.Run("Nil function", func(t *testing.T) {
+			list, nodes := testListSlice(5)
+			assert.Panics(t, func() { list.GetByValue(nodes[0].Value, nil) })
+		})
+	})
 }
 
 func BenchmarkGetByValue(b *testing.B) {
@@ -766,6 +939,7 @@ func TestGetAllValues(t *testing.T) {
 			list.Append(node)
 			indexes := list.GetAllValues(node.Value, nil)
 			assert.Equal(t, map[int]*Node[int]{3: node}, indexes)
+			assert.Nil(t, list.Validate())
 		})
 
 		t.Run("Multiple", func(t *testing.T) {
@@ -782,6 +956,7 @@ func TestGetAllValues(t *testing.T) {
 			list.Append(node3)
 			indexes := list.GetAllValues(value, nil)
 			assert.Equal(t, map[int]*Node[int]{1: node1, 3: node2, 5: node3}, indexes)
+			assert.Nil(t, list.Validate())
 		})
 	})
 
@@ -810,6 +985,16 @@ func TestGetAllValues(t *testing.T) {
 		}
 		indexes = list.GetAllValues(value, nil)
 		assert.Empty(t, indexes)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Slice", func(t *testing.T) {
+		list, nodes := testListSlice(3)
+		node := NewNode([]int{2, 0})
+		list.Append(node)
+		indexes := list.GetAllValues([]int{2}, equalFirst)
+		assert.Equal(t, map[int]*Node[[]int]{1: nodes[1], 3: node}, indexes)
+		assert.Nil(t, list.Validate())
 	})
 }
 
@@ -886,26 +1071,29 @@ func TestSwap(t *testing.T) {
 			assert.Nil(t, err)
 			assert.Equal(t, nodes[testSet[1]], retrieved)
 		}
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Out of range", func(t *testing.T) {
 		list, _ := testListInt(5)
 		err := list.Swap(1, 5)
-		assert.Equal(t, &IndexOutOfRangeError{Index: 5}, err)
+		assert.Equal(t, &IndexOutOfRangeError{Op: "Swap", Index: 5, Length: 5}, err)
 		err = list.Swap(5, 1)
-		assert.Equal(t, &IndexOutOfRangeError{Index: 5}, err)
+		assert.Equal(t, &IndexOutOfRangeError{Op: "Swap", Index: 5, Length: 5}, err)
 		err = list.Swap(5, 6)
-		assert.Equal(t, &IndexOutOfRangeError{Index: 5}, err)
+		assert.Equal(t, &IndexOutOfRangeError{Op: "Swap", Index: 5, Length: 5}, err)
 		err = list.Swap(6, 5)
-		assert.Equal(t, &IndexOutOfRangeError{Index: 6}, err)
+		assert.Equal(t, &IndexOutOfRangeError{Op: "Swap", Index: 6, Length: 5}, err)
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Negative index", func(t *testing.T) {
 		list, _ := testListInt(5)
 		err := list.Swap(-1, 2)
-		assert.Equal(t, &NegativeIndexError{Index: -1}, err)
+		assert.Equal(t, &NegativeIndexError{Op: "Swap", Index: -1}, err)
 		err = list.Swap(3, -1)
-		assert.Equal(t, &NegativeIndexError{Index: -1}, err)
+		assert.Equal(t, &NegativeIndexError{Op: "Swap", Index: -1}, err)
+		assert.Nil(t, list.Validate())
 	})
 }
 
@@ -941,6 +1129,301 @@ func BenchmarkSwap(b *testing.B) {
 	})
 }
 
+func TestMoveToFront(t *testing.T) {
+	testCases := []struct {
+		name     string
+		index    int
+		expected []int
+	}{
+		{name: "Head", index: 0, expected: []int{1, 2, 3, 4}},
+		{name: "Middle", index: 2, expected: []int{3, 1, 2, 4}},
+		{name: "Tail", index: 3, expected: []int{4, 1, 2, 3}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			list, nodes := testListInt(4)
+			err := list.MoveToFront(nodes[tc.index])
+			assert.Nil(t, err)
+			forward, backward := listValues(list)
+			assert.Equal(t, tc.expected, forward)
+			assert.Equal(t, tc.expected, backward)
+			assert.Equal(t, nodes[tc.index], list.head)
+			assert.Equal(t, 4, list.length)
+			assert.Nil(t, list.Validate())
+		})
+	}
+
+	t.Run("Not found", func(t *testing.T) {
+		list, _ := testListInt(3)
+		_, otherNodes := testListInt(3)
+		err := list.MoveToFront(otherNodes[1])
+		assert.Equal(t, &NodeNotFoundError[int]{Op: "MoveToFront", Node: otherNodes[1]}, err)
+		err = list.MoveToFront(nil)
+		assert.Equal(t, &NodeNotFoundError[int]{Op: "MoveToFront"}, err)
+		assert.Nil(t, list.Validate())
+	})
+}
+
+func TestMoveToBack(t *testing.T) {
+	testCases := []struct {
+		name     string
+		index    int
+		expected []int
+	}{
+		{name: "Head", index: 0, expected: []int{2, 3, 4, 1}},
+		{name: "Middle", index: 1, expected: []int{1, 3, 4, 2}},
+		{name: "Tail", index: 3, expected: []int{1, 2, 3, 4}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			list, nodes := testListInt(4)
+			err := list.MoveToBack(nodes[tc.index])
+			assert.Nil(t, err)
+			forward, backward := listValues(list)
+			assert.Equal(t, tc.expected, forward)
+			assert.Equal(t, tc.expected, backward)
+			assert.Equal(t, nodes[tc.index], list.tail)
+			assert.Equal(t, 4, list.length)
+			assert.Nil(t, list.Validate())
+		})
+	}
+
+	t.Run("Single", func(t *testing.T) {
+		list, nodes := testListInt(1)
+		err := list.MoveToBack(nodes[0])
+		assert.Nil(t, err)
+		assert.Equal(t, nodes[0], list.head)
+		assert.Equal(t, nodes[0], list.tail)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		list, _ := testListInt(3)
+		node := NewNode(5)
+		err := list.MoveToBack(node)
+		assert.Equal(t, &NodeNotFoundError[int]{Op: "MoveToBack", Node: node}, err)
+		assert.Nil(t, list.Validate())
+	})
+}
+
+func TestMoveBefore(t *testing.T) {
+	testCases := []struct {
+		name     string
+		node     int
+		mark     int
+		expected []int
+	}{
+		{name: "Before head", node: 2, mark: 0, expected: []int{3, 1, 2, 4}},
+		{name: "Tail before head", node: 3, mark: 0, expected: []int{4, 1, 2, 3}},
+		{name: "Head before tail", node: 0, mark: 3, expected: []int{2, 3, 1, 4}},
+		{name: "Neighbours", node: 2, mark: 1, expected: []int{1, 3, 2, 4}},
+		{name: "Already before", node: 1, mark: 2, expected: []int{1, 2, 3, 4}},
+		{name: "Same node", node: 1, mark: 1, expected: []int{1, 2, 3, 4}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			list, nodes := testListInt(4)
+			err := list.MoveBefore(nodes[tc.node], nodes[tc.mark])
+			assert.Nil(t, err)
+			forward, backward := listValues(list)
+			assert.Equal(t, tc.expected, forward)
+			assert.Equal(t, tc.expected, backward)
+			assert.Equal(t, 4, list.length)
+			assert.Nil(t, list.Validate())
+		})
+	}
+
+	t.Run("Not found", func(t *testing.T) {
+		list, nodes := testListInt(3)
+		node := NewNode(5)
+		err := list.MoveBefore(node, nodes[0])
+		assert.Equal(t, &NodeNotFoundError[int]{Op: "MoveBefore", Node: node}, err)
+		err = list.MoveBefore(nodes[0], node)
+		assert.Equal(t, &NodeNotFoundError[int]{Op: "MoveBefore", Node: node}, err)
+		assert.Nil(t, list.Validate())
+	})
+}
+
+func TestMoveAfter(t *testing.T) {
+	testCases := []struct {
+		name     string
+		node     int
+		mark     int
+		expected []int
+	}{
+		{name: "After tail", node: 1, mark: 3, expected: []int{1, 3, 4, 2}},
+		{name: "Head after tail", node: 0, mark: 3, expected: []int{2, 3, 4, 1}},
+		{name: "Tail after head", node: 3, mark: 0, expected: []int{1, 4, 2, 3}},
+		{name: "Neighbours", node: 1, mark: 2, expected: []int{1, 3, 2, 4}},
+		{name: "Already after", node: 2, mark: 1, expected: []int{1, 2, 3, 4}},
+		{name: "Same node", node: 1, mark: 1, expected: []int{1, 2, 3, 4}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			list, nodes := testListInt(4)
+			err := list.MoveAfter(nodes[tc.node], nodes[tc.mark])
+			assert.Nil(t, err)
+			forward, backward := listValues(list)
+			assert.Equal(t, tc.expected, forward)
+			assert.Equal(t, tc.expected, backward)
+			assert.Equal(t, 4, list.length)
+			assert.Nil(t, list.Validate())
+		})
+	}
+
+	t.Run("Not found", func(t *testing.T) {
+		list, nodes := testListInt(3)
+		_, otherNodes := testListInt(3)
+		err := list.MoveAfter(otherNodes[0], nodes[0])
+		assert.Equal(t, &NodeNotFoundError[int]{Op: "MoveAfter", Node: otherNodes[0]}, err)
+		err = list.MoveAfter(nodes[0], otherNodes[0])
+		assert.Equal(t, &NodeNotFoundError[int]{Op: "MoveAfter", Node: otherNodes[0]}, err)
+		assert.Nil(t, list.Validate())
+	})
+}
+
+func TestSplitAt(t *testing.T) {
+	testCases := []struct {
+		name   string
+		index  int
+		first  []int
+		second []int
+	}{
+		{name: "Beginning", index: 0, first: []int{}, second: []int{1, 2, 3, 4}},
+		{name: "Middle", index: 2, first: []int{1, 2}, second: []int{3, 4}},
+		{name: "Tail", index: 3, first: []int{1, 2, 3}, second: []int{4}},
+		{name: "End", index: 4, first: []int{1, 2, 3, 4}, second: []int{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			list, nodes := testListInt(4)
+			other, err := list.SplitAt(tc.index)
+			assert.Nil(t, err)
+			forward, backward := listValues(list)
+			assert.Equal(t, tc.first, forward)
+			assert.Equal(t, tc.first, backward)
+			assert.Equal(t, len(tc.first), list.length)
+			forward, backward = listValues(other)
+			assert.Equal(t, tc.second, forward)
+			assert.Equal(t, tc.second, backward)
+			assert.Equal(t, len(tc.second), other.length)
+			for i, node := range nodes {
+				if i < tc.index {
+					assert.Equal(t, list, node.List())
+				} else {
+					assert.Equal(t, other, node.List())
+				}
+			}
+			assert.Nil(t, list.Validate())
+			assert.Nil(t, other.Validate())
+		})
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		list := &List[int]{}
+		other, err := list.SplitAt(0)
+		assert.Nil(t, err)
+		assert.Equal(t, &List[int]{}, other)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Out of range", func(t *testing.T) {
+		list, _ := testListInt(3)
+		other, err := list.SplitAt(4)
+		assert.Equal(t, &IndexOutOfRangeError{Op: "SplitAt", Index: 4, Length: 3}, err)
+		assert.Nil(t, other)
+		assert.Equal(t, 3, list.length)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Negative index", func(t *testing.T) {
+		list, _ := testListInt(3)
+		other, err := list.SplitAt(-1)
+		assert.Equal(t, &NegativeIndexError{Op: "SplitAt", Index: -1}, err)
+		assert.Nil(t, other)
+		assert.Nil(t, list.Validate())
+	})
+}
+
+func TestSplice(t *testing.T) {
+	testCases := []struct {
+		name     string
+		at       int
+		expected []int
+	}{
+		{name: "Beginning", at: -1, expected: []int{10, 20, 1, 2, 3}},
+		{name: "After head", at: 0, expected: []int{1, 10, 20, 2, 3}},
+		{name: "After tail", at: 2, expected: []int{1, 2, 3, 10, 20}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			list, nodes := testListInt(3)
+			other := &List[int]{}
+			otherNodes := []*Node[int]{NewNode(10), NewNode(20)}
+			for _, node := range otherNodes {
+				other.Append(node)
+			}
+			var at *Node[int]
+			if tc.at >= 0 {
+				at = nodes[tc.at]
+			}
+			err := list.Splice(at, other)
+			assert.Nil(t, err)
+			forward, backward := listValues(list)
+			assert.Equal(t, tc.expected, forward)
+			assert.Equal(t, tc.expected, backward)
+			assert.Equal(t, 5, list.length)
+			assert.Equal(t, &List[int]{}, other)
+			for _, node := range otherNodes {
+				assert.Equal(t, list, node.List())
+			}
+			assert.Nil(t, list.Validate())
+			assert.Nil(t, other.Validate())
+		})
+	}
+
+	t.Run("Into empty", func(t *testing.T) {
+		list := &List[int]{}
+		other, _ := testListInt(2)
+		err := list.Splice(nil, other)
+		assert.Nil(t, err)
+		forward, backward := listValues(list)
+		assert.Equal(t, []int{1, 2}, forward)
+		assert.Equal(t, []int{1, 2}, backward)
+		assert.Equal(t, 0, other.length)
+		assert.Nil(t, list.Validate())
+		assert.Nil(t, other.Validate())
+	})
+
+	t.Run("Empty other", func(t *testing.T) {
+		list, nodes := testListInt(2)
+		err := list.Splice(nodes[0], &List[int]{})
+		assert.Nil(t, err)
+		assert.Equal(t, 2, list.length)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Itself", func(t *testing.T) {
+		list, nodes := testListInt(2)
+		err := list.Splice(nodes[0], list)
+		assert.Nil(t, err)
+		forward, _ := listValues(list)
+		assert.Equal(t, []int{1, 2}, forward)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		list, _ := testListInt(2)
+		other, otherNodes := testListInt(2)
+		err := list.Splice(otherNodes[0], other)
+		assert.Equal(t, &NodeNotFoundError[int]{Op: "Splice", Node: otherNodes[0]}, err)
+		assert.Equal(t, 2, list.length)
+		assert.Equal(t, 2, other.length)
+		assert.Nil(t, list.Validate())
+		assert.Nil(t, other.Validate())
+	})
+}
+
 func TestDeleteAt(t *testing.T) {
 	t.Run("In the middle", func(t *testing.T) {
 		list, nodes := testListInt(5)
@@ -958,6 +1441,7 @@ func TestDeleteAt(t *testing.T) {
 		retrieved, err = list.GetByIndex(3)
 		assert.Nil(t, err)
 		assert.Equal(t, nodes[4], retrieved)
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Next to tail", func(t *testing.T) {
@@ -973,6 +1457,7 @@ func TestDeleteAt(t *testing.T) {
 		retrieved, err = list.GetByIndex(2)
 		assert.Nil(t, err)
 		assert.Equal(t, nodes[3], retrieved)
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Next to head", func(t *testing.T) {
@@ -988,6 +1473,7 @@ func TestDeleteAt(t *testing.T) {
 		retrieved, err = list.GetByIndex(2)
 		assert.Nil(t, err)
 		assert.Equal(t, nodes[3], retrieved)
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Between head and tail", func(t *testing.T) {
@@ -1000,6 +1486,7 @@ func TestDeleteAt(t *testing.T) {
 		retrieved, err = list.GetByIndex(1)
 		assert.Nil(t, err)
 		assert.Equal(t, nodes[2], retrieved)
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Last node", func(t *testing.T) {
@@ -1008,16 +1495,18 @@ func TestDeleteAt(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Nil(t, list.head)
 		assert.Nil(t, list.tail)
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Out of range", func(t *testing.T) {
 		list := &List[int]{}
 		err := list.DeleteAt(0)
-		assert.Equal(t, &IndexOutOfRangeError{Index: 0}, err)
+		assert.Equal(t, &IndexOutOfRangeError{Op: "DeleteAt", Index: 0, Length: 0}, err)
 
 		list, _ = testListInt(5)
 		err = list.DeleteAt(5)
-		assert.Equal(t, &IndexOutOfRangeError{Index: 5}, err)
+		assert.Equal(t, &IndexOutOfRangeError{Op: "DeleteAt", Index: 5, Length: 5}, err)
+		assert.Nil(t, list.Validate())
 	})
 }
 
@@ -1060,7 +1549,8 @@ func TestDeleteNode(t *testing.T) {
 	t.Run("Empty", func(t *testing.T) {
 		list, node := &List[int]{}, &Node[int]{}
 		err := list.DeleteNode(node)
-		assert.Equal(t, &NodeNotFoundError[int]{Node: node}, err)
+		assert.Equal(t, &NodeNotFoundError[int]{Op: "DeleteNode", Node: node}, err)
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Head", func(t *testing.T) {
@@ -1070,6 +1560,7 @@ func TestDeleteNode(t *testing.T) {
 		assert.Equal(t, nodes[1], list.head)
 		assert.Equal(t, nodes[2], list.head.next)
 		assert.Nil(t, list.head.previous)
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Middle", func(t *testing.T) {
@@ -1083,6 +1574,7 @@ func TestDeleteNode(t *testing.T) {
 		assert.Equal(t, nodes[0], list.tail.previous)
 		assert.Nil(t, list.head.previous)
 		assert.Nil(t, list.tail.next)
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Tail", func(t *testing.T) {
@@ -1092,6 +1584,7 @@ func TestDeleteNode(t *testing.T) {
 		assert.Equal(t, nodes[1], list.tail)
 		assert.Equal(t, nodes[0], list.tail.previous)
 		assert.Nil(t, list.tail.next)
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Last", func(t *testing.T) {
@@ -1100,19 +1593,43 @@ func TestDeleteNode(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Nil(t, list.tail)
 		assert.Nil(t, list.head)
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Nil", func(t *testing.T) {
 		list, _ := testListInt(3)
 		err := list.DeleteNode(nil)
 		assert.Nil(t, err)
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Not found", func(t *testing.T) {
 		list, _ := testListInt(3)
 		node := NewNode(123)
 		err := list.DeleteNode(node)
-		assert.Equal(t, &NodeNotFoundError[int]{Node: node}, err)
+		assert.Equal(t, &NodeNotFoundError[int]{Op: "DeleteNode", Node: node}, err)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Foreign node", func(t *testing.T) {
+		list, _ := testListInt(3)
+		other, otherNodes := testListInt(3)
+		err := list.DeleteNode(otherNodes[1])
+		assert.Equal(t, &NodeNotFoundError[int]{Op: "DeleteNode", Node: otherNodes[1]}, err)
+		assert.Equal(t, 3, list.length)
+		assert.Equal(t, 3, other.length)
+		assert.Equal(t, otherNodes[2], otherNodes[1].next)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Twice", func(t *testing.T) {
+		list, nodes := testListInt(3)
+		err := list.DeleteNode(nodes[1])
+		assert.Nil(t, err)
+		err = list.DeleteNode(nodes[1])
+		assert.Equal(t, &NodeNotFoundError[int]{Op: "DeleteNode", Node: nodes[1]}, err)
+		assert.Equal(t, 2, list.length)
+		assert.Nil(t, list.Validate())
 	})
 }
 
@@ -1157,6 +1674,7 @@ func TestDeleteValues(t *testing.T) {
 		deleted := list.DeleteValues(node.Value, nil)
 		assert.Equal(t, 0, deleted)
 
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Head", func(t *testing.T) {
@@ -1166,6 +1684,7 @@ func TestDeleteValues(t *testing.T) {
 		assert.Equal(t, nodes[1], list.head)
 		assert.Equal(t, nodes[2], list.head.next)
 		assert.Nil(t, list.head.previous)
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Middle", func(t *testing.T) {
@@ -1180,6 +1699,7 @@ func TestDeleteValues(t *testing.T) {
 		assert.Equal(t, nodes[0], list.tail.previous)
 		assert.Nil(t, list.head.previous)
 		assert.Nil(t, list.tail.next)
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Tail", func(t *testing.T) {
@@ -1189,6 +1709,7 @@ func TestDeleteValues(t *testing.T) {
 		assert.Equal(t, nodes[1], list.tail)
 		assert.Equal(t, nodes[0], list.tail.previous)
 		assert.Nil(t, list.tail.next)
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Head and tail", func(t *testing.T) {
@@ -1202,6 +1723,7 @@ func TestDeleteValues(t *testing.T) {
 		assert.Equal(t, nodes[2], list.tail)
 		assert.Equal(t, nodes[1], list.tail.previous)
 		assert.Nil(t, list.tail.next)
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Last", func(t *testing.T) {
@@ -1211,6 +1733,7 @@ func TestDeleteValues(t *testing.T) {
 		assert.Equal(t, 0, list.length)
 		assert.Nil(t, list.head)
 		assert.Nil(t, list.tail)
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("All", func(t *testing.T) {
@@ -1224,6 +1747,7 @@ func TestDeleteValues(t *testing.T) {
 		assert.Equal(t, 0, list.length)
 		assert.Nil(t, list.head)
 		assert.Nil(t, list.tail)
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Custom func", func(t *testing.T) {
@@ -1237,6 +1761,18 @@ func TestDeleteValues(t *testing.T) {
 		assert.Equal(t, nodes[3], list.tail.previous)
 		assert.Nil(t, list.head.previous)
 		assert.Nil(t, list.tail.next)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Slice", func(t *testing.T) {
+		list, nodes := testListSlice(3)
+		list.Append(NewNode([]int{2, 0}))
+		deleted := list.DeleteValues([]int{2}, equalFirst)
+		assert.Equal(t, 2, deleted)
+		assert.Equal(t, 2, list.length)
+		assert.Equal(t, nodes[0], list.head)
+		assert.Equal(t, nodes[2], list.tail)
+		assert.Nil(t, list.Validate())
 	})
 }
 
@@ -1281,6 +1817,39 @@ func BenchmarkDeleteValues(b *testing.B) {
 	})
 }
 
+func TestClear(t *testing.T) {
+	t.Run("Empty list", func(t *testing.T) {
+		list := &List[int]{}
+		list.Clear()
+		assert.Equal(t, 0, list.Length())
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Nonempty list", func(t *testing.T) {
+		list, nodes := testListInt(3)
+		list.Clear()
+		assert.Nil(t, list.Head())
+		assert.Nil(t, list.Tail())
+		assert.Equal(t, 0, list.Length())
+		for _, node := range nodes {
+			assert.Nil(t, node.List())
+			assert.Nil(t, node.Next())
+			assert.Nil(t, node.Previous())
+		}
+		assert.Nil(t, list.Append(nodes[0]))
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Undo", func(t *testing.T) {
+		list, nodes := testListInt(3)
+		list.EnableHistory(0)
+		list.Clear()
+		assert.True(t, list.Undo())
+		assert.Equal(t, nodes, listNodes(list))
+		assert.Nil(t, list.Validate())
+	})
+}
+
 func TestSort(t *testing.T) {
 	t.Run("Asc", func(t *testing.T) {
 		list, nodes := &List[int]{}, testNodesInt(5)
@@ -1304,6 +1873,7 @@ func TestSort(t *testing.T) {
 		}
 		assert.Equal(t, list.head, nodes[0])
 		assert.Equal(t, list.tail, nodes[4])
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Desc", func(t *testing.T) {
@@ -1330,6 +1900,7 @@ func TestSort(t *testing.T) {
 		}
 		assert.Equal(t, list.head, nodes[0])
 		assert.Equal(t, list.tail, nodes[5])
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Sorted", func(t *testing.T) {
@@ -1349,6 +1920,7 @@ func TestSort(t *testing.T) {
 		}
 		assert.Equal(t, list.head, nodes[0])
 		assert.Equal(t, list.tail, nodes[4])
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Single", func(t *testing.T) {
@@ -1357,6 +1929,7 @@ func TestSort(t *testing.T) {
 		assert.Equal(t, list.length, 1)
 		assert.Equal(t, list.head, nodes[0])
 		assert.Equal(t, list.tail, nodes[0])
+		assert.Nil(t, list.Validate())
 	})
 
 	t.Run("Empty", func(t *testing.T) {
@@ -1365,6 +1938,60 @@ func TestSort(t *testing.T) {
 		assert.Equal(t, list.length, 0)
 		assert.Nil(t, list.head)
 		assert.Nil(t, list.tail)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Stable", func(t *testing.T) {
+		list := &List[PersonTest]{}
+		people := []PersonTest{
+			{ID: 2, FirstName: "Bruce"},
+			{ID: 1, FirstName: "Clark"},
+			{ID: 2, FirstName: "Diana"},
+			{ID: 1, FirstName: "Barry"},
+			{ID: 2, FirstName: "Arthur"},
+		}
+		for _, p := range people {
+			list.Append(NewNode(p))
+		}
+		list.Sort(func(v1, v2 PersonTest) bool { return v1.ID < v2.ID })
+		expected := []string{"Clark", "Barry", "Bruce", "Diana", "Arthur"}
+		for i, v := range list.All() {
+			assert.Equal(t, expected[i], v.FirstName)
+		}
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Random", func(t *testing.T) {
+		n := 100001
+		list := generateRandomList(n)
+		list.Sort(func(v1, v2 int) bool { return v1 < v2 })
+		assert.Equal(t, n, list.length)
+		assert.Nil(t, list.head.previous)
+		assert.Nil(t, list.tail.next)
+		assert.Equal(t, 0, list.head.Value)
+		assert.Equal(t, n-1, list.tail.Value)
+		c := 0
+		for current := list.head; current != nil; current = current.next {
+			assert.Equal(t, c, current.Value)
+			if current.next != nil && current.next.previous != current {
+				assert.Fail(t, "Broken previous link", "Node with value %v", current.next.Value)
+			}
+			c++
+		}
+		assert.Equal(t, n, c)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Slice", func(t *testing.T) {
+		list := &List[[]int]{}
+		list.Append(NewNode([]int{3}))
+		list.Append(NewNode([]int{1}))
+		list.Append(NewNode([]int{2}))
+		list.Sort(func(v1, v2 []int) bool { return v1[0] < v2[0] })
+		forward, backward := listValues(list)
+		assert.Equal(t, [][]int{{1}, {2}, {3}}, forward)
+		assert.Equal(t, [][]int{{1}, {2}, {3}}, backward)
+		assert.Nil(t, list.Validate())
 	})
 }
 
@@ -0,0 +1,129 @@
+// Package lru implements least recently used cache on top of doubly linked list.
+package lru
+
+import (
+	"time"
+
+	"github.com/matijakrajnik/godll"
+)
+
+// Entry stored in list. Zero expires means that entry never expires.
+type entry[K comparable, V any] struct {
+	key     K
+	value   V
+	expires time.Time
+}
+
+// Cache is least recently used cache. Most recently used entry is kept at the head of the list,
+// so least recently used entry is evicted from the tail when capacity is exceeded.
+type Cache[K comparable, V any] struct {
+	capacity int                            // Maximum number of entries. Zero or negative means unlimited.
+	onEvict  func(key K, value V)           // Function called for evicted and expired entries.
+	now      func() time.Time               // Function returning current time.
+	items    map[K]*godll.Node[entry[K, V]] // Nodes by key.
+	list     godll.List[entry[K, V]]        // Entries ordered from most to least recently used.
+}
+
+// New creates new cache with passed capacity. If capacity is zero or negative, cache size is unlimited.
+// onEvict is called for every entry removed because capacity was exceeded or because entry expired. Pass nil to skip it.
+func New[K comparable, V any](capacity int, onEvict func(key K, value V)) *Cache[K, V] {
+	return &Cache[K, V]{
+		capacity: capacity,
+		onEvict:  onEvict,
+		now:      time.Now,
+		items:    make(map[K]*godll.Node[entry[K, V]]),
+	}
+}
+
+// Get returns value for key and marks entry as most recently used. Return zero value and false if key is not found or entry expired.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	node, ok := c.find(key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	c.list.MoveToFront(node)
+	return node.Value.value, true
+}
+
+// Peek returns value for key without marking entry as used. Return zero value and false if key is not found or entry expired.
+func (c *Cache[K, V]) Peek(key K) (V, bool) {
+	node, ok := c.find(key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	return node.Value.value, true
+}
+
+// Put adds value for key, which never expires, and marks entry as most recently used.
+// If key already exists its value is replaced. Least recently used entry is evicted if capacity is exceeded.
+func (c *Cache[K, V]) Put(key K, value V) {
+	c.put(key, value, time.Time{})
+}
+
+// PutWithTTL adds value for key, which expires after ttl, and marks entry as most recently used.
+// If key already exists its value is replaced. Least recently used entry is evicted if capacity is exceeded.
+func (c *Cache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	c.put(key, value, c.now().Add(ttl))
+}
+
+// Remove deletes entry for key without calling eviction function. Return false if key is not found.
+func (c *Cache[K, V]) Remove(key K) bool {
+	node, ok := c.items[key]
+	if !ok {
+		return false
+	}
+
+	c.list.DeleteNode(node)
+	delete(c.items, key)
+	return true
+}
+
+// Len returns number of entries in cache. Expired entries are counted until they are accessed or evicted.
+func (c *Cache[K, V]) Len() int {
+	return c.list.Length()
+}
+
+func (c *Cache[K, V]) put(key K, value V, expires time.Time) {
+	if node, ok := c.items[key]; ok {
+		node.Value.value = value
+		node.Value.expires = expires
+		c.list.MoveToFront(node)
+		return
+	}
+
+	c.items[key] = c.list.PushFront(entry[K, V]{key: key, value: value, expires: expires})
+
+	// Evict least recently used entries from the tail.
+	for c.capacity > 0 && c.list.Length() > c.capacity {
+		c.evict(c.list.Tail())
+	}
+}
+
+// Find node for key. Expired node is evicted and reported as not found.
+func (c *Cache[K, V]) find(key K) (*godll.Node[entry[K, V]], bool) {
+	node, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	if !node.Value.expires.IsZero() && !c.now().Before(node.Value.expires) {
+		c.evict(node)
+		return nil, false
+	}
+
+	return node, true
+}
+
+// Remove node from cache and call eviction function.
+func (c *Cache[K, V]) evict(node *godll.Node[entry[K, V]]) {
+	e := node.Value
+	c.list.DeleteNode(node)
+	delete(c.items, e.key)
+	if c.onEvict != nil {
+		c.onEvict(e.key, e.value)
+	}
+}
@@ -0,0 +1,179 @@
+package lru
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type evicted struct {
+	key   string
+	value int
+}
+
+// Create test cache which records evicted entries and uses controllable clock.
+func testCache(capacity int) (*Cache[string, int], *[]evicted, *time.Time) {
+	evictions := []evicted{}
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := New(capacity, func(key string, value int) {
+		evictions = append(evictions, evicted{key: key, value: value})
+	})
+	cache.now = func() time.Time { return now }
+	return cache, &evictions, &now
+}
+
+// Collect keys from most to least recently used.
+func keys(cache *Cache[string, int]) []string {
+	keys := []string{}
+	for v := range cache.list.Values() {
+		keys = append(keys, v.key)
+	}
+	return keys
+}
+
+func TestGet(t *testing.T) {
+	t.Run("Empty", func(t *testing.T) {
+		cache, _, _ := testCache(2)
+		v, ok := cache.Get("a")
+		assert.False(t, ok)
+		assert.Equal(t, 0, v)
+	})
+
+	t.Run("Found", func(t *testing.T) {
+		cache, _, _ := testCache(3)
+		cache.Put("a", 1)
+		cache.Put("b", 2)
+		cache.Put("c", 3)
+		v, ok := cache.Get("a")
+		assert.True(t, ok)
+		assert.Equal(t, 1, v)
+		assert.Equal(t, []string{"a", "c", "b"}, keys(cache))
+		assert.Nil(t, cache.list.Validate())
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		cache, evictions, now := testCache(3)
+		cache.PutWithTTL("a", 1, time.Minute)
+		cache.Put("b", 2)
+		*now = now.Add(time.Minute)
+		_, ok := cache.Get("a")
+		assert.False(t, ok)
+		assert.Equal(t, 1, cache.Len())
+		assert.Equal(t, []evicted{{key: "a", value: 1}}, *evictions)
+		v, ok := cache.Get("b")
+		assert.True(t, ok)
+		assert.Equal(t, 2, v)
+	})
+}
+
+func TestPeek(t *testing.T) {
+	t.Run("Not found", func(t *testing.T) {
+		cache, _, _ := testCache(2)
+		_, ok := cache.Peek("a")
+		assert.False(t, ok)
+	})
+
+	t.Run("Order unchanged", func(t *testing.T) {
+		cache, _, _ := testCache(2)
+		cache.Put("a", 1)
+		cache.Put("b", 2)
+		v, ok := cache.Peek("a")
+		assert.True(t, ok)
+		assert.Equal(t, 1, v)
+		assert.Equal(t, []string{"b", "a"}, keys(cache))
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		cache, evictions, now := testCache(2)
+		cache.PutWithTTL("a", 1, time.Second)
+		*now = now.Add(time.Millisecond)
+		_, ok := cache.Peek("a")
+		assert.True(t, ok)
+		*now = now.Add(time.Second)
+		_, ok = cache.Peek("a")
+		assert.False(t, ok)
+		assert.Equal(t, 0, cache.Len())
+		assert.Equal(t, []evicted{{key: "a", value: 1}}, *evictions)
+	})
+}
+
+func TestPut(t *testing.T) {
+	t.Run("Eviction", func(t *testing.T) {
+		cache, evictions, _ := testCache(2)
+		cache.Put("a", 1)
+		cache.Put("b", 2)
+		cache.Get("a")
+		cache.Put("c", 3)
+		assert.Equal(t, []string{"c", "a"}, keys(cache))
+		assert.Equal(t, []evicted{{key: "b", value: 2}}, *evictions)
+		_, ok := cache.Get("b")
+		assert.False(t, ok)
+		assert.Equal(t, 2, cache.Len())
+		assert.Nil(t, cache.list.Validate())
+	})
+
+	t.Run("Replace", func(t *testing.T) {
+		cache, evictions, _ := testCache(2)
+		cache.Put("a", 1)
+		cache.Put("b", 2)
+		cache.Put("a", 10)
+		assert.Equal(t, []string{"a", "b"}, keys(cache))
+		assert.Empty(t, *evictions)
+		v, _ := cache.Get("a")
+		assert.Equal(t, 10, v)
+		assert.Equal(t, 2, cache.Len())
+	})
+
+	t.Run("Replace removes TTL", func(t *testing.T) {
+		cache, _, now := testCache(2)
+		cache.PutWithTTL("a", 1, time.Second)
+		cache.Put("a", 2)
+		*now = now.Add(time.Hour)
+		v, ok := cache.Get("a")
+		assert.True(t, ok)
+		assert.Equal(t, 2, v)
+	})
+
+	t.Run("Unlimited", func(t *testing.T) {
+		cache, evictions, _ := testCache(0)
+		for i := 0; i < 100; i++ {
+			cache.Put(string(rune('a'+i)), i)
+		}
+		assert.Equal(t, 100, cache.Len())
+		assert.Empty(t, *evictions)
+	})
+
+	t.Run("Nil eviction function", func(t *testing.T) {
+		cache := New[int, int](1, nil)
+		cache.Put(1, 1)
+		cache.Put(2, 2)
+		_, ok := cache.Get(1)
+		assert.False(t, ok)
+		assert.Equal(t, 1, cache.Len())
+	})
+}
+
+func TestRemove(t *testing.T) {
+	cache, evictions, _ := testCache(2)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	assert.True(t, cache.Remove("a"))
+	assert.False(t, cache.Remove("a"))
+	assert.Equal(t, 1, cache.Len())
+	assert.Equal(t, []string{"b"}, keys(cache))
+	assert.Empty(t, *evictions)
+	assert.Nil(t, cache.list.Validate())
+}
+
+func TestLen(t *testing.T) {
+	cache, _, now := testCache(3)
+	assert.Equal(t, 0, cache.Len())
+	cache.Put("a", 1)
+	cache.PutWithTTL("b", 2, time.Second)
+	assert.Equal(t, 2, cache.Len())
+	*now = now.Add(time.Minute)
+	assert.Equal(t, 2, cache.Len())
+	cache.Get("b")
+	assert.Equal(t, 1, cache.Len())
+}
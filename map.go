@@ -0,0 +1,264 @@
+// Ordered map backed by doubly linked list.
+
+package godll
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"reflect"
+	"strconv"
+)
+
+// Key and value pair stored in list of OrderedMap.
+type mapEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// OrderedMap is map which keeps order in which keys were added.
+// Entries are stored in List and indexed by map, so Set, Get and Delete take constant time.
+// Zero value is an empty map ready to use.
+type OrderedMap[K comparable, V any] struct {
+	list  List[mapEntry[K, V]]        // Entries in insertion order.
+	nodes map[K]*Node[mapEntry[K, V]] // Nodes of list indexed by their keys.
+}
+
+// NewOrderedMap creates empty OrderedMap.
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{nodes: map[K]*Node[mapEntry[K, V]]{}}
+}
+
+// Len returns number of entries in map.
+func (m *OrderedMap[K, V]) Len() int {
+	return m.list.Length()
+}
+
+// Get returns value for key. Return zero value and false if key is not in map.
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	node, ok := m.nodes[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return node.Value.value, true
+}
+
+// Set sets value for key. New key is added to the end of map, while existing key keeps its position.
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	if node, ok := m.nodes[key]; ok {
+		node.Value.value = value
+		return
+	}
+	if m.nodes == nil {
+		m.nodes = map[K]*Node[mapEntry[K, V]]{}
+	}
+	m.nodes[key] = m.list.PushBack(mapEntry[K, V]{key: key, value: value})
+}
+
+// Delete removes entry for key. Return false if key is not in map.
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	node, ok := m.nodes[key]
+	if !ok {
+		return false
+	}
+	delete(m.nodes, key)
+	m.list.DeleteNode(node)
+	return true
+}
+
+// MoveToFront moves entry for key to the beginning of map. Return false if key is not in map.
+func (m *OrderedMap[K, V]) MoveToFront(key K) bool {
+	node, ok := m.nodes[key]
+	if !ok {
+		return false
+	}
+	m.list.MoveToFront(node)
+	return true
+}
+
+// MoveToBack moves entry for key to the end of map. Return false if key is not in map.
+func (m *OrderedMap[K, V]) MoveToBack(key K) bool {
+	node, ok := m.nodes[key]
+	if !ok {
+		return false
+	}
+	m.list.MoveToBack(node)
+	return true
+}
+
+// Clear removes all entries from map.
+func (m *OrderedMap[K, V]) Clear() {
+	m.list.Clear()
+	clear(m.nodes)
+}
+
+// All returns iterator over key and value pairs in insertion order.
+func (m *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := range m.list.Values() {
+			if !yield(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns iterator over key and value pairs in reverse insertion order.
+func (m *OrderedMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, e := range m.list.Backward() {
+			if !yield(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns iterator over keys in insertion order.
+func (m *OrderedMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for e := range m.list.Values() {
+			if !yield(e.key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns iterator over values in insertion order.
+func (m *OrderedMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for e := range m.list.Values() {
+			if !yield(e.value) {
+				return
+			}
+		}
+	}
+}
+
+// MarshalJSON encodes OrderedMap as JSON object with keys in insertion order.
+// Keys are encoded like keys of Go maps: key must be a string, an integer, or implement encoding.TextMarshaler.
+// It has value receiver, so OrderedMap is encoded with its entries also when it is held by value, for example in struct field.
+func (m OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for e := range m.list.Values() {
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		name, err := marshalKey(e.key)
+		if err != nil {
+			return nil, err
+		}
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(e.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes JSON object into OrderedMap, keeping order of keys from data. Existing entries are removed.
+// If key is repeated, its last value is kept at position of its first occurrence.
+// Map is left unchanged if data can't be decoded.
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	t, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	// Like for Go maps, null leaves map unchanged.
+	if t == nil {
+		return nil
+	}
+	if t != json.Delim('{') {
+		return fmt.Errorf("godll: cannot unmarshal %v into OrderedMap", t)
+	}
+
+	decoded := NewOrderedMap[K, V]()
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, err := unmarshalKey[K](t.(string))
+		if err != nil {
+			return err
+		}
+		var value V
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+		decoded.Set(key, value)
+	}
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	m.Clear()
+	for key, value := range decoded.All() {
+		m.Set(key, value)
+	}
+	return nil
+}
+
+// Convert key to name of JSON object member, the same way as encoding/json converts keys of Go maps.
+func marshalKey[K comparable](key K) (string, error) {
+	v := reflect.ValueOf(&key).Elem()
+	if v.Kind() == reflect.String {
+		return v.String(), nil
+	}
+	if tm, ok := any(key).(encoding.TextMarshaler); ok {
+		text, err := tm.MarshalText()
+		return string(text), err
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	}
+	return "", &json.UnsupportedTypeError{Type: v.Type()}
+}
+
+// Convert name of JSON object member to key, the same way as encoding/json converts keys of Go maps.
+func unmarshalKey[K comparable](name string) (K, error) {
+	var key K
+	if tu, ok := any(&key).(encoding.TextUnmarshaler); ok {
+		err := tu.UnmarshalText([]byte(name))
+		return key, err
+	}
+
+	v := reflect.ValueOf(&key).Elem()
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(name)
+		return key, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(name, 10, 64)
+		if err != nil || v.OverflowInt(n) {
+			return key, &json.UnmarshalTypeError{Value: "number " + name, Type: v.Type()}
+		}
+		v.SetInt(n)
+		return key, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(name, 10, 64)
+		if err != nil || v.OverflowUint(n) {
+			return key, &json.UnmarshalTypeError{Value: "number " + name, Type: v.Type()}
+		}
+		v.SetUint(n)
+		return key, nil
+	}
+	return key, &json.UnmarshalTypeError{Value: "object key", Type: v.Type()}
+}
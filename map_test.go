@@ -0,0 +1,201 @@
+package godll
+
+import (
+	"encoding/json"
+	"net/netip"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Collect keys and values of map in iteration order.
+func mapPairs[K comparable, V any](seq func(yield func(K, V) bool)) ([]K, []V) {
+	var keys []K
+	var values []V
+	for k, v := range seq {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	return keys, values
+}
+
+func TestOrderedMap(t *testing.T) {
+	t.Run("Zero value", func(t *testing.T) {
+		m := &OrderedMap[string, int]{}
+		assert.Equal(t, 0, m.Len())
+		_, ok := m.Get("a")
+		assert.False(t, ok)
+		assert.False(t, m.Delete("a"))
+		assert.False(t, m.MoveToFront("a"))
+		assert.False(t, m.MoveToBack("a"))
+		m.Set("a", 1)
+		v, ok := m.Get("a")
+		assert.True(t, ok)
+		assert.Equal(t, 1, v)
+	})
+
+	t.Run("Set", func(t *testing.T) {
+		m := NewOrderedMap[string, int]()
+		m.Set("b", 1)
+		m.Set("a", 2)
+		m.Set("c", 3)
+		m.Set("b", 4)
+		assert.Equal(t, 3, m.Len())
+		assert.Equal(t, []string{"b", "a", "c"}, slices.Collect(m.Keys()))
+		assert.Equal(t, []int{4, 2, 3}, slices.Collect(m.Values()))
+		keys, values := mapPairs(m.All())
+		assert.Equal(t, []string{"b", "a", "c"}, keys)
+		assert.Equal(t, []int{4, 2, 3}, values)
+		keys, values = mapPairs(m.Backward())
+		assert.Equal(t, []string{"c", "a", "b"}, keys)
+		assert.Equal(t, []int{3, 2, 4}, values)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		m := NewOrderedMap[int, string]()
+		m.Set(1, "a")
+		m.Set(2, "b")
+		m.Set(3, "c")
+		assert.True(t, m.Delete(2))
+		assert.False(t, m.Delete(2))
+		_, ok := m.Get(2)
+		assert.False(t, ok)
+		m.Set(2, "d")
+		assert.Equal(t, []int{1, 3, 2}, slices.Collect(m.Keys()))
+		assert.Nil(t, m.list.Validate())
+	})
+
+	t.Run("Move", func(t *testing.T) {
+		m := NewOrderedMap[int, int]()
+		for i := 1; i <= 4; i++ {
+			m.Set(i, i*10)
+		}
+		assert.True(t, m.MoveToFront(3))
+		assert.True(t, m.MoveToBack(1))
+		assert.Equal(t, []int{3, 2, 4, 1}, slices.Collect(m.Keys()))
+		assert.Equal(t, []int{30, 20, 40, 10}, slices.Collect(m.Values()))
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		m := NewOrderedMap[int, int]()
+		m.Set(1, 1)
+		m.Clear()
+		assert.Equal(t, 0, m.Len())
+		_, ok := m.Get(1)
+		assert.False(t, ok)
+	})
+}
+
+func TestOrderedMapMarshalJSON(t *testing.T) {
+	t.Run("Empty map", func(t *testing.T) {
+		data, err := json.Marshal(&OrderedMap[string, int]{})
+		assert.Nil(t, err)
+		assert.Equal(t, "{}", string(data))
+	})
+
+	t.Run("Value field", func(t *testing.T) {
+		var v struct{ M OrderedMap[string, int] }
+		v.M.Set("b", 2)
+		v.M.Set("a", 1)
+		data, err := json.Marshal(v)
+		assert.Nil(t, err)
+		assert.Equal(t, `{"M":{"b":2,"a":1}}`, string(data))
+	})
+
+	t.Run("String keys", func(t *testing.T) {
+		m := NewOrderedMap[string, any]()
+		m.Set("z", 1)
+		m.Set("a", []int{1, 2})
+		m.Set(`"q"`, nil)
+		data, err := json.Marshal(m)
+		assert.Nil(t, err)
+		assert.Equal(t, `{"z":1,"a":[1,2],"\"q\"":null}`, string(data))
+	})
+
+	t.Run("Int keys", func(t *testing.T) {
+		m := NewOrderedMap[int, string]()
+		m.Set(10, "a")
+		m.Set(-2, "b")
+		data, err := json.Marshal(m)
+		assert.Nil(t, err)
+		assert.Equal(t, `{"10":"a","-2":"b"}`, string(data))
+	})
+
+	t.Run("TextMarshaler keys", func(t *testing.T) {
+		m := NewOrderedMap[netip.Addr, int]()
+		m.Set(netip.MustParseAddr("10.0.0.2"), 2)
+		m.Set(netip.MustParseAddr("10.0.0.1"), 1)
+		data, err := json.Marshal(m)
+		assert.Nil(t, err)
+		assert.Equal(t, `{"10.0.0.2":2,"10.0.0.1":1}`, string(data))
+	})
+
+	t.Run("Unsupported keys", func(t *testing.T) {
+		m := NewOrderedMap[float64, int]()
+		m.Set(1.5, 1)
+		_, err := json.Marshal(m)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestOrderedMapUnmarshalJSON(t *testing.T) {
+	t.Run("Keep order", func(t *testing.T) {
+		m := NewOrderedMap[string, int]()
+		m.Set("old", 0)
+		err := json.Unmarshal([]byte(`{"z":1,"a":2,"m":3,"a":4}`), m)
+		assert.Nil(t, err)
+		keys, values := mapPairs(m.All())
+		assert.Equal(t, []string{"z", "a", "m"}, keys)
+		assert.Equal(t, []int{1, 4, 3}, values)
+	})
+
+	t.Run("Int keys", func(t *testing.T) {
+		m := &OrderedMap[uint8, string]{}
+		assert.Nil(t, json.Unmarshal([]byte(`{"3":"a","1":"b"}`), m))
+		assert.Equal(t, []uint8{3, 1}, slices.Collect(m.Keys()))
+		assert.NotNil(t, json.Unmarshal([]byte(`{"300":"a"}`), m))
+		assert.NotNil(t, json.Unmarshal([]byte(`{"a":"a"}`), m))
+		assert.Equal(t, []uint8{3, 1}, slices.Collect(m.Keys()))
+	})
+
+	t.Run("TextUnmarshaler keys", func(t *testing.T) {
+		m := &OrderedMap[netip.Addr, int]{}
+		assert.Nil(t, json.Unmarshal([]byte(`{"10.0.0.2":2,"10.0.0.1":1}`), m))
+		assert.Equal(t, []netip.Addr{netip.MustParseAddr("10.0.0.2"), netip.MustParseAddr("10.0.0.1")}, slices.Collect(m.Keys()))
+	})
+
+	t.Run("Round trip", func(t *testing.T) {
+		m := NewOrderedMap[string, PersonTest]()
+		m.Set("b", PersonTest{ID: 2, FirstName: "Bruce"})
+		m.Set("a", PersonTest{ID: 1, FirstName: "Clark"})
+		data, err := json.Marshal(m)
+		assert.Nil(t, err)
+		decoded := &OrderedMap[string, PersonTest]{}
+		assert.Nil(t, json.Unmarshal(data, decoded))
+		keys, values := mapPairs(decoded.All())
+		assert.Equal(t, []string{"b", "a"}, keys)
+		assert.Equal(t, []PersonTest{{ID: 2, FirstName: "Bruce"}, {ID: 1, FirstName: "Clark"}}, values)
+	})
+
+	t.Run("Null", func(t *testing.T) {
+		m := NewOrderedMap[string, int]()
+		m.Set("a", 1)
+		assert.Nil(t, json.Unmarshal([]byte(`null`), m))
+		assert.Equal(t, 1, m.Len())
+	})
+
+	t.Run("Invalid data", func(t *testing.T) {
+		m := NewOrderedMap[string, int]()
+		m.Set("a", 1)
+		assert.NotNil(t, json.Unmarshal([]byte(`[1,2]`), m))
+		assert.NotNil(t, json.Unmarshal([]byte(`{"b":"x"}`), m))
+		assert.Equal(t, []string{"a"}, slices.Collect(m.Keys()))
+	})
+
+	t.Run("Field", func(t *testing.T) {
+		var s struct{ Map *OrderedMap[string, int] }
+		assert.Nil(t, json.Unmarshal([]byte(`{"Map":{"b":1,"a":2}}`), &s))
+		assert.Equal(t, []string{"b", "a"}, slices.Collect(s.Map.Keys()))
+	})
+}
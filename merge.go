@@ -0,0 +1,162 @@
+// Merging of sorted doubly linked lists.
+
+package godll
+
+import (
+	"container/heap"
+	"slices"
+)
+
+// MergeSorted moves all nodes from list b into list a, keeping them sorted by function less.
+// Both lists must already be sorted by less. Merging is stable: for equal values, nodes of a come first.
+// Nodes are relinked in O(n+m) time without allocation. List b is empty after merging.
+// Merging can't be undone or rolled back, so history of both lists is discarded.
+func MergeSorted[T any](a, b *List[T], less fun[T]) {
+	if b == nil || a == b || b.length == 0 {
+		return
+	}
+	a.discardChanges()
+	b.discardChanges()
+
+	if len(a.observers) > 0 {
+		mergeReported(a, b, less)
+		b.emit(Event[T]{Kind: EventCleared, Index: -1, OldIndex: -1})
+		return
+	}
+
+	head, tail := merge(a.head, b.head, less)
+
+	// Restore previous links and move ownership of all nodes to a.
+	var previous *Node[T]
+	for current := head; current != nil; current = current.next {
+		current.previous = previous
+		current.list = a
+		previous = current
+	}
+	a.head, a.tail = head, tail
+	a.length += b.length
+	b.head, b.tail, b.length = nil, nil, 0
+
+	b.emit(Event[T]{Kind: EventCleared, Index: -1, OldIndex: -1})
+}
+
+// Move nodes from b into a one by one, so every insertion into a is reported.
+// Insertions are not recorded, because b can't be restored by undoing them.
+func mergeReported[T any](a, b *List[T], less fun[T]) {
+	recorder := a.recorder
+	a.recorder = nil
+	defer func() {
+		a.recorder = recorder
+	}()
+
+	var mark *Node[T]
+	current := a.head
+	for b.head != nil {
+		node := b.head
+		// Skip nodes of a which must stay before node. Node is placed before node of a only if it is less, to keep merging stable.
+		for current != nil && !less(node.Value, current.Value) {
+			mark = current
+			current = current.next
+		}
+
+		// Unlink node from b without deleting it, so it isn't recycled by arena of b.
+		b.unlink(node)
+		node.list = nil
+		b.length--
+
+		a.insertAfter(node, mark)
+		mark = node
+	}
+}
+
+// MergeK moves all nodes from passed lists into new List, keeping them sorted by function less.
+// All lists must already be sorted by less. Merging is stable: for equal values, nodes of earlier list come first.
+// Next node is chosen with a heap, so merging takes O(n log k) time, where n is number of nodes and k number of lists.
+// Passed lists are empty after merging, and their history is discarded. Nil lists are skipped.
+func MergeK[T any](lists []*List[T], less fun[T]) *List[T] {
+	result := &List[T]{}
+	h := &mergeHeap[T]{less: less}
+	for i, l := range lists {
+		// Skip empty lists and lists which were already passed.
+		if l == nil || l.length == 0 || slices.Contains(lists[:i], l) {
+			continue
+		}
+		h.items = append(h.items, mergeItem[T]{node: l.head, source: i})
+	}
+	heap.Init(h)
+
+	for h.Len() > 0 {
+		// Take the lowest node and replace it in heap with node which follows it in its list.
+		item := &h.items[0]
+		node := item.node
+		if node.next != nil {
+			item.node = node.next
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+
+		node.list = result
+		node.previous = result.tail
+		node.next = nil
+		if result.tail == nil {
+			result.head = node
+		} else {
+			result.tail.next = node
+		}
+		result.tail = node
+		result.length++
+	}
+
+	for _, l := range lists {
+		if l == nil || l.length == 0 {
+			continue
+		}
+		l.discardChanges()
+		l.head, l.tail, l.length = nil, nil, 0
+		l.emit(Event[T]{Kind: EventCleared, Index: -1, OldIndex: -1})
+	}
+
+	return result
+}
+
+// First node of remaining part of list being merged.
+type mergeItem[T any] struct {
+	node   *Node[T] // Lowest node of list, which was not merged yet.
+	source int      // Index of list, used to keep merging stable.
+}
+
+// Heap of lists being merged, ordered by their first nodes.
+type mergeHeap[T any] struct {
+	items []mergeItem[T]
+	less  fun[T]
+}
+
+func (h *mergeHeap[T]) Len() int {
+	return len(h.items)
+}
+
+func (h *mergeHeap[T]) Less(i, j int) bool {
+	v1, v2 := h.items[i].node.Value, h.items[j].node.Value
+	if h.less(v1, v2) {
+		return true
+	}
+	if h.less(v2, v1) {
+		return false
+	}
+	return h.items[i].source < h.items[j].source
+}
+
+func (h *mergeHeap[T]) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+}
+
+func (h *mergeHeap[T]) Push(x any) {
+	h.items = append(h.items, x.(mergeItem[T]))
+}
+
+func (h *mergeHeap[T]) Pop() any {
+	item := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return item
+}
@@ -0,0 +1,187 @@
+package godll
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func lessInt(v1, v2 int) bool {
+	return v1 < v2
+}
+
+func TestMergeSorted(t *testing.T) {
+	testCases := []struct {
+		name     string
+		a, b     []int
+		expected []int
+	}{
+		{name: "Both empty", a: []int{}, b: []int{}, expected: []int{}},
+		{name: "Empty a", a: []int{}, b: []int{1, 2}, expected: []int{1, 2}},
+		{name: "Empty b", a: []int{1, 2}, b: []int{}, expected: []int{1, 2}},
+		{name: "Interleaved", a: []int{1, 4, 6}, b: []int{2, 3, 5, 7}, expected: []int{1, 2, 3, 4, 5, 6, 7}},
+		{name: "b before a", a: []int{5, 6}, b: []int{1, 2}, expected: []int{1, 2, 5, 6}},
+		{name: "b after a", a: []int{1, 2}, b: []int{5, 6}, expected: []int{1, 2, 5, 6}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a, b := FromSlice(tc.a), FromSlice(tc.b)
+			bNodes := listNodes(b)
+			MergeSorted(a, b, lessInt)
+			forward, backward := listValues(a)
+			assert.Equal(t, tc.expected, append([]int{}, forward...))
+			assert.Equal(t, tc.expected, append([]int{}, backward...))
+			assert.Equal(t, 0, b.Length())
+			assert.Nil(t, b.Head())
+			assert.Nil(t, b.Tail())
+			for _, node := range bNodes {
+				assert.Equal(t, a, node.List())
+			}
+			assert.Nil(t, a.Validate())
+			assert.Nil(t, b.Validate())
+		})
+	}
+
+	t.Run("Stable", func(t *testing.T) {
+		a := FromValues(PersonTest{ID: 1, FirstName: "a1"}, PersonTest{ID: 2, FirstName: "a2"})
+		b := FromValues(PersonTest{ID: 1, FirstName: "b1"}, PersonTest{ID: 2, FirstName: "b2"})
+		MergeSorted(a, b, func(p1, p2 PersonTest) bool { return p1.ID < p2.ID })
+		names := Map(a, func(p PersonTest) string { return p.FirstName }).ToSlice()
+		assert.Equal(t, []string{"a1", "b1", "a2", "b2"}, names)
+	})
+
+	t.Run("Same list", func(t *testing.T) {
+		a := FromValues(1, 2)
+		MergeSorted(a, a, lessInt)
+		MergeSorted(a, nil, lessInt)
+		assert.Equal(t, []int{1, 2}, a.ToSlice())
+		assert.Nil(t, a.Validate())
+	})
+
+	t.Run("Allocations", func(t *testing.T) {
+		a, b := &List[int]{}, &List[int]{}
+		nodes := testNodesInt(100)
+		allocs := testing.AllocsPerRun(10, func() {
+			a.Clear()
+			for i, node := range nodes {
+				if i%3 == 0 {
+					b.Append(node)
+				} else {
+					a.Append(node)
+				}
+			}
+			MergeSorted(a, b, lessInt)
+		})
+		assert.Equal(t, 0.0, allocs)
+		assert.Equal(t, 100, a.Length())
+	})
+
+	t.Run("Observed with history", func(t *testing.T) {
+		a, b := FromValues(1, 3, 5), FromValues(2, 3, 6)
+		bNodes := listNodes(b)
+		a.EnableHistory(0)
+		events := recordEvents(a)
+		bEvents := recordEvents(b)
+		MergeSorted(a, b, lessInt)
+
+		assert.Equal(t, []int{1, 2, 3, 3, 5, 6}, a.ToSlice())
+		assert.Equal(t, []Event[int]{
+			{Kind: EventInserted, Node: bNodes[0], Index: 1, OldIndex: -1},
+			{Kind: EventInserted, Node: bNodes[1], Index: 3, OldIndex: -1},
+			{Kind: EventInserted, Node: bNodes[2], Index: 5, OldIndex: -1},
+		}, *events)
+		assert.Equal(t, []Event[int]{{Kind: EventCleared, Index: -1, OldIndex: -1}}, *bEvents)
+		assert.Equal(t, 0, b.Length())
+		assert.Nil(t, b.Validate())
+
+		// Merging can't be undone, so nodes of b are never lost.
+		assert.False(t, a.Undo())
+		assert.Equal(t, []int{1, 2, 3, 3, 5, 6}, a.ToSlice())
+		assert.Nil(t, a.Validate())
+
+		a.PushBack(7)
+		assert.True(t, a.Undo())
+		assert.Equal(t, 6, a.Length())
+		assert.Nil(t, a.Validate())
+	})
+
+	t.Run("History discarded", func(t *testing.T) {
+		a, b := FromValues(1, 5), FromValues(2, 6)
+		a.EnableHistory(0)
+		a.PushBack(7)
+		MergeSorted(a, b, lessInt)
+		assert.False(t, a.Undo())
+		assert.Equal(t, []int{1, 2, 5, 6, 7}, a.ToSlice())
+		assert.Nil(t, a.Validate())
+	})
+}
+
+func TestMergeK(t *testing.T) {
+	t.Run("No lists", func(t *testing.T) {
+		result := MergeK(nil, lessInt)
+		assert.Equal(t, 0, result.Length())
+		assert.Nil(t, result.Validate())
+	})
+
+	t.Run("Multiple lists", func(t *testing.T) {
+		lists := []*List[int]{
+			FromValues(1, 5, 9),
+			nil,
+			FromValues(2, 3, 10, 11),
+			&List[int]{},
+			FromValues(0, 4),
+		}
+		result := MergeK(lists, lessInt)
+		forward, backward := listValues(result)
+		assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 9, 10, 11}, forward)
+		assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 9, 10, 11}, backward)
+		for node := range result.Nodes() {
+			assert.Equal(t, result, node.List())
+		}
+		for _, l := range lists {
+			if l != nil {
+				assert.Equal(t, 0, l.Length())
+				assert.Nil(t, l.Validate())
+			}
+		}
+		assert.Nil(t, result.Validate())
+	})
+
+	t.Run("Repeated list", func(t *testing.T) {
+		list := FromValues(1, 2)
+		result := MergeK([]*List[int]{list, list}, lessInt)
+		assert.Equal(t, []int{1, 2}, result.ToSlice())
+		assert.Nil(t, result.Validate())
+	})
+
+	t.Run("Stable", func(t *testing.T) {
+		lists := []*List[PersonTest]{
+			FromValues(PersonTest{ID: 1, FirstName: "a1"}, PersonTest{ID: 2, FirstName: "a2"}),
+			FromValues(PersonTest{ID: 1, FirstName: "b1"}),
+			FromValues(PersonTest{ID: 0, FirstName: "c0"}, PersonTest{ID: 1, FirstName: "c1"}),
+		}
+		result := MergeK(lists, func(p1, p2 PersonTest) bool { return p1.ID < p2.ID })
+		names := Map(result, func(p PersonTest) string { return p.FirstName }).ToSlice()
+		assert.Equal(t, []string{"c0", "a1", "b1", "c1", "a2"}, names)
+	})
+
+	t.Run("Random lists", func(t *testing.T) {
+		var lists []*List[int]
+		var expected []int
+		for range 10 {
+			values := make([]int, rand.Intn(50))
+			for i := range values {
+				values[i] = rand.Intn(100)
+			}
+			slices.Sort(values)
+			expected = append(expected, values...)
+			lists = append(lists, FromSlice(values))
+		}
+		slices.Sort(expected)
+		result := MergeK(lists, lessInt)
+		assert.Equal(t, expected, append([]int{}, result.ToSlice()...))
+		assert.Nil(t, result.Validate())
+	})
+}
@@ -3,10 +3,12 @@
 package godll
 
 // Node represent node in linked list.
-type Node[T comparable] struct {
-	Value    T        // Value of node.
-	next     *Node[T] // Pointer to next node.
-	previous *Node[T] // Pointer to previous node.
+type Node[T any] struct {
+	Value    T         // Value of node.
+	next     *Node[T]  // Pointer to next node.
+	previous *Node[T]  // Pointer to previous node.
+	list     *List[T]  // Pointer to list which node belongs to.
+	arena    *arena[T] // Arena which allocated node, nil if node was allocated separately.
 }
 
 // Next returns pointer to next Node[T] in list.
@@ -19,7 +21,12 @@ func (n *Node[T]) Previous() *Node[T] {
 	return n.previous
 }
 
+// List returns pointer to List[T] which node belongs to. Returns nil if node is not in any list.
+func (n *Node[T]) List() *List[T] {
+	return n.list
+}
+
 // NewNode cretes new node with passed value. Return pointer to newly created node.
-func NewNode[T comparable](value T) *Node[T] {
+func NewNode[T any](value T) *Node[T] {
 	return &Node[T]{Value: value}
 }
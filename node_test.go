@@ -33,6 +33,12 @@ func TestNewNode(t *testing.T) {
 		assert.Equal(t, person.LastName, node.Value.LastName)
 		assert.Nil(t, node.Next())
 	})
+
+	t.Run("Slice", func(t *testing.T) {
+		node := NewNode([]int{1, 2})
+		assert.Equal(t, []int{1, 2}, node.Value)
+		assert.Nil(t, node.Next())
+	})
 }
 
 func TestNext(t *testing.T) {
@@ -50,3 +56,31 @@ func TestPrevious(t *testing.T) {
 	assert.Equal(t, nodes[0], nodes[1].Previous())
 	assert.Equal(t, nodes[1], nodes[2].Previous())
 }
+
+func TestList(t *testing.T) {
+	t.Run("New node", func(t *testing.T) {
+		assert.Nil(t, NewNode(1).List())
+	})
+
+	t.Run("After Append/Prepend/Insert", func(t *testing.T) {
+		list, nodes := &List[int]{}, testNodesInt(3)
+		assert.Nil(t, list.Append(nodes[0]))
+		assert.Nil(t, list.Prepend(nodes[1]))
+		assert.Nil(t, list.InsertAt(1, nodes[2]))
+		for _, node := range nodes {
+			assert.Equal(t, list, node.List())
+		}
+	})
+
+	t.Run("After Delete", func(t *testing.T) {
+		list, nodes := testListInt(3)
+		assert.Nil(t, list.DeleteNode(nodes[0]))
+		assert.Nil(t, list.DeleteAt(0))
+		assert.Equal(t, 1, list.DeleteValues(nodes[2].Value, nil))
+		for _, node := range nodes {
+			assert.Nil(t, node.List())
+			assert.Nil(t, node.Next())
+			assert.Nil(t, node.Previous())
+		}
+	})
+}
@@ -0,0 +1,245 @@
+// Package persistent implements immutable sequence with structural sharing, which can be converted to and from godll.List.
+//
+// Every modification returns new version of sequence, while old version stays unchanged and usable.
+// Sequence is stored as balanced AVL tree indexed by position, and modification copies only path from root
+// to modified position, so new version shares all other nodes with old one. Modifications and lookups take O(log n) time.
+package persistent
+
+import (
+	"iter"
+
+	"github.com/matijakrajnik/godll"
+)
+
+// Node of balanced tree. Nodes are never modified after they are created, so they can be shared between versions.
+type node[T any] struct {
+	value  T        // Value at position of node.
+	left   *node[T] // Subtree with values before node.
+	right  *node[T] // Subtree with values after node.
+	size   int      // Number of nodes in subtree.
+	height int      // Height of subtree.
+}
+
+// List is immutable sequence of values. Zero value is an empty sequence ready to use.
+type List[T any] struct {
+	root *node[T] // Root of balanced tree.
+}
+
+// FromList creates new List with all values from godll.List, in order from head to tail.
+func FromList[T any](l *godll.List[T]) List[T] {
+	values := make([]T, 0, l.Length())
+	for v := range l.Values() {
+		values = append(values, v)
+	}
+	return FromSlice(values)
+}
+
+// FromSlice creates new List with all values from slice.
+func FromSlice[T any](values []T) List[T] {
+	return List[T]{root: build(values)}
+}
+
+// ToList creates new godll.List with all values, in order from first to last.
+func (l List[T]) ToList() *godll.List[T] {
+	list := &godll.List[T]{}
+	for v := range l.Values() {
+		list.PushBack(v)
+	}
+	return list
+}
+
+// Length returns number of values in List.
+func (l List[T]) Length() int {
+	return size(l.root)
+}
+
+// GetByIndex retrieves value by index. Return error if index is out of range. Index of first value is 0.
+func (l List[T]) GetByIndex(index int) (T, error) {
+	if err := validateExistingIndex("GetByIndex", index, l.Length()); err != nil {
+		var zero T
+		return zero, err
+	}
+
+	n := l.root
+	for {
+		ls := size(n.left)
+		switch {
+		case index < ls:
+			n = n.left
+		case index > ls:
+			index -= ls + 1
+			n = n.right
+		default:
+			return n.value, nil
+		}
+	}
+}
+
+// Append returns new version of List with value added to the end.
+func (l List[T]) Append(value T) List[T] {
+	return List[T]{root: insert(l.root, l.Length(), value)}
+}
+
+// Prepend returns new version of List with value added to the beginning.
+func (l List[T]) Prepend(value T) List[T] {
+	return List[T]{root: insert(l.root, 0, value)}
+}
+
+// InsertAt returns new version of List with value inserted at specific position.
+func (l List[T]) InsertAt(index int, value T) (List[T], error) {
+	if err := validateInsertableIndex("InsertAt", index, l.Length()); err != nil {
+		return l, err
+	}
+	return List[T]{root: insert(l.root, index, value)}, nil
+}
+
+// DeleteAt returns new version of List without value at given index.
+func (l List[T]) DeleteAt(index int) (List[T], error) {
+	if err := validateExistingIndex("DeleteAt", index, l.Length()); err != nil {
+		return l, err
+	}
+	return List[T]{root: remove(l.root, index)}, nil
+}
+
+// All returns iterator over index and value pairs, starting from first value.
+func (l List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		walk(l.root, func(value T) bool {
+			if !yield(i, value) {
+				return false
+			}
+			i++
+			return true
+		})
+	}
+}
+
+// Values returns iterator over values, starting from first value.
+func (l List[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		walk(l.root, yield)
+	}
+}
+
+func validateExistingIndex(op string, index, length int) error {
+	if index < 0 {
+		return &godll.NegativeIndexError{Op: op, Index: index}
+	}
+	if index >= length {
+		return &godll.IndexOutOfRangeError{Op: op, Index: index, Length: length}
+	}
+	return nil
+}
+
+func validateInsertableIndex(op string, index, length int) error {
+	if index < 0 {
+		return &godll.NegativeIndexError{Op: op, Index: index}
+	}
+	if index > length {
+		return &godll.IndexOutOfRangeError{Op: op, Index: index, Length: length}
+	}
+	return nil
+}
+
+// Visit values of subtree in order. Return false if visiting was stopped.
+func walk[T any](n *node[T], visit func(T) bool) bool {
+	if n == nil {
+		return true
+	}
+	return walk(n.left, visit) && visit(n.value) && walk(n.right, visit)
+}
+
+func size[T any](n *node[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+func height[T any](n *node[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// Create new node with passed subtrees.
+func newNode[T any](value T, left, right *node[T]) *node[T] {
+	return &node[T]{
+		value:  value,
+		left:   left,
+		right:  right,
+		size:   size(left) + size(right) + 1,
+		height: max(height(left), height(right)) + 1,
+	}
+}
+
+// Build balanced tree from values.
+func build[T any](values []T) *node[T] {
+	if len(values) == 0 {
+		return nil
+	}
+	m := len(values) / 2
+	return newNode(values[m], build(values[:m]), build(values[m+1:]))
+}
+
+// Create new node with passed subtrees, rotating them if their heights differ by more than one.
+func balance[T any](value T, left, right *node[T]) *node[T] {
+	switch {
+	case height(left) > height(right)+1:
+		// Single right rotation if left subtree is heavier on the outside, double rotation otherwise.
+		if height(left.left) >= height(left.right) {
+			return newNode(left.value, left.left, newNode(value, left.right, right))
+		}
+		lr := left.right
+		return newNode(lr.value, newNode(left.value, left.left, lr.left), newNode(value, lr.right, right))
+
+	case height(right) > height(left)+1:
+		// Single left rotation if right subtree is heavier on the outside, double rotation otherwise.
+		if height(right.right) >= height(right.left) {
+			return newNode(right.value, newNode(value, left, right.left), right.right)
+		}
+		rl := right.left
+		return newNode(rl.value, newNode(value, left, rl.left), newNode(right.value, rl.right, right.right))
+	}
+
+	return newNode(value, left, right)
+}
+
+// Return new subtree with value inserted at index.
+func insert[T any](n *node[T], index int, value T) *node[T] {
+	if n == nil {
+		return newNode[T](value, nil, nil)
+	}
+
+	ls := size(n.left)
+	if index <= ls {
+		return balance(n.value, insert(n.left, index, value), n.right)
+	}
+	return balance(n.value, n.left, insert(n.right, index-ls-1, value))
+}
+
+// Return new subtree without value at index.
+func remove[T any](n *node[T], index int) *node[T] {
+	ls := size(n.left)
+	switch {
+	case index < ls:
+		return balance(n.value, remove(n.left, index), n.right)
+	case index > ls:
+		return balance(n.value, n.left, remove(n.right, index-ls-1))
+	}
+
+	// Replace removed node with first node of right subtree.
+	if n.left == nil {
+		return n.right
+	}
+	if n.right == nil {
+		return n.left
+	}
+	first := n.right
+	for first.left != nil {
+		first = first.left
+	}
+	return balance(first.value, n.left, remove(n.right, 0))
+}
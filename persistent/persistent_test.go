@@ -0,0 +1,202 @@
+package persistent
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/matijakrajnik/godll"
+	"github.com/stretchr/testify/assert"
+)
+
+// Collect values of list and check that tree is balanced and sizes are correct.
+func values[T any](t *testing.T, l List[T]) []T {
+	var check func(n *node[T]) int
+	check = func(n *node[T]) int {
+		if n == nil {
+			return 0
+		}
+		hl, hr := check(n.left), check(n.right)
+		assert.LessOrEqual(t, hl-hr, 1)
+		assert.LessOrEqual(t, hr-hl, 1)
+		assert.Equal(t, size(n.left)+size(n.right)+1, n.size)
+		assert.Equal(t, max(hl, hr)+1, n.height)
+		return n.height
+	}
+	check(l.root)
+
+	values := []T{}
+	for v := range l.Values() {
+		values = append(values, v)
+	}
+	return values
+}
+
+func TestEmpty(t *testing.T) {
+	var l List[int]
+	assert.Equal(t, 0, l.Length())
+	assert.Equal(t, []int{}, values(t, l))
+	_, err := l.GetByIndex(0)
+	assert.Equal(t, &godll.IndexOutOfRangeError{Op: "GetByIndex", Index: 0, Length: 0}, err)
+}
+
+func TestAppendPrepend(t *testing.T) {
+	var l List[int]
+	for i := 1; i <= 5; i++ {
+		l = l.Append(i)
+	}
+	l = l.Prepend(0)
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5}, values(t, l))
+	assert.Equal(t, 6, l.Length())
+}
+
+func TestVersions(t *testing.T) {
+	v1 := FromSlice([]int{1, 2, 3})
+	v2 := v1.Append(4)
+	v3, err := v2.InsertAt(1, 10)
+	assert.Nil(t, err)
+	v4, err := v3.DeleteAt(0)
+	assert.Nil(t, err)
+	v5 := v1.Prepend(0)
+
+	assert.Equal(t, []int{1, 2, 3}, values(t, v1))
+	assert.Equal(t, []int{1, 2, 3, 4}, values(t, v2))
+	assert.Equal(t, []int{1, 10, 2, 3, 4}, values(t, v3))
+	assert.Equal(t, []int{10, 2, 3, 4}, values(t, v4))
+	assert.Equal(t, []int{0, 1, 2, 3}, values(t, v5))
+}
+
+func TestStructuralSharing(t *testing.T) {
+	v1 := FromSlice(rand.Perm(1023))
+	v2 := v1.Append(-1)
+
+	// Count nodes of new version which are not shared with old one.
+	shared := map[*node[int]]bool{}
+	var collect func(n *node[int])
+	collect = func(n *node[int]) {
+		if n != nil {
+			shared[n] = true
+			collect(n.left)
+			collect(n.right)
+		}
+	}
+	collect(v1.root)
+	copied := 0
+	var count func(n *node[int])
+	count = func(n *node[int]) {
+		if n == nil {
+			return
+		}
+		if !shared[n] {
+			copied++
+			count(n.left)
+			count(n.right)
+		}
+	}
+	count(v2.root)
+	assert.LessOrEqual(t, copied, 2*v2.root.height)
+}
+
+func TestInsertAt(t *testing.T) {
+	l := FromSlice([]int{1, 2, 3})
+	for i, expected := range [][]int{{0, 1, 2, 3}, {1, 0, 2, 3}, {1, 2, 0, 3}, {1, 2, 3, 0}} {
+		inserted, err := l.InsertAt(i, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, values(t, inserted))
+	}
+
+	inserted, err := l.InsertAt(4, 0)
+	assert.Equal(t, &godll.IndexOutOfRangeError{Op: "InsertAt", Index: 4, Length: 3}, err)
+	assert.Equal(t, l, inserted)
+	_, err = l.InsertAt(-1, 0)
+	assert.Equal(t, &godll.NegativeIndexError{Op: "InsertAt", Index: -1}, err)
+}
+
+func TestDeleteAt(t *testing.T) {
+	l := FromSlice([]int{1, 2, 3, 4, 5})
+	for i := 0; i < 5; i++ {
+		deleted, err := l.DeleteAt(i)
+		assert.Nil(t, err)
+		expected := []int{}
+		for j := 1; j <= 5; j++ {
+			if j != i+1 {
+				expected = append(expected, j)
+			}
+		}
+		assert.Equal(t, expected, values(t, deleted))
+	}
+
+	deleted, err := l.DeleteAt(5)
+	assert.Equal(t, &godll.IndexOutOfRangeError{Op: "DeleteAt", Index: 5, Length: 5}, err)
+	assert.Equal(t, l, deleted)
+}
+
+func TestGetByIndex(t *testing.T) {
+	l := FromSlice([]string{"a", "b", "c", "d"})
+	for i, expected := range []string{"a", "b", "c", "d"} {
+		v, err := l.GetByIndex(i)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, v)
+	}
+	_, err := l.GetByIndex(-1)
+	assert.Equal(t, &godll.NegativeIndexError{Op: "GetByIndex", Index: -1}, err)
+}
+
+func TestAll(t *testing.T) {
+	l := FromSlice([]int{5, 6, 7})
+	c := 0
+	for i, v := range l.All() {
+		assert.Equal(t, c, i)
+		assert.Equal(t, 5+i, v)
+		c++
+	}
+	assert.Equal(t, 3, c)
+
+	for i := range l.All() {
+		if i == 1 {
+			break
+		}
+		c++
+	}
+	assert.Equal(t, 4, c)
+}
+
+func TestConversion(t *testing.T) {
+	list := &godll.List[int]{}
+	for i := 1; i <= 4; i++ {
+		list.PushBack(i)
+	}
+	l := FromList(list)
+	assert.Equal(t, []int{1, 2, 3, 4}, values(t, l))
+
+	converted := l.Append(5).ToList()
+	assert.Nil(t, converted.Validate())
+	assert.Equal(t, 5, converted.Length())
+	i := 0
+	for v := range converted.Values() {
+		i++
+		assert.Equal(t, i, v)
+	}
+	assert.Equal(t, 4, list.Length())
+}
+
+func TestRandomOperations(t *testing.T) {
+	var l List[int]
+	expected := []int{}
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 2000; i++ {
+		if r.Intn(3) > 0 || len(expected) == 0 {
+			index := r.Intn(len(expected) + 1)
+			var err error
+			l, err = l.InsertAt(index, i)
+			assert.Nil(t, err)
+			expected = append(expected[:index], append([]int{i}, expected[index:]...)...)
+		} else {
+			index := r.Intn(len(expected))
+			var err error
+			l, err = l.DeleteAt(index)
+			assert.Nil(t, err)
+			expected = append(expected[:index], expected[index+1:]...)
+		}
+	}
+	assert.Equal(t, expected, values(t, l))
+}
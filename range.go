@@ -0,0 +1,139 @@
+// Operations on ranges of doubly linked list.
+
+package godll
+
+// Return error if range from index from up to, but not including, index to isn't within list of passed length.
+func validateRange(op string, from, to, length int) error {
+	if err := validateInsertableIndex(op, to, length); err != nil {
+		return err
+	}
+	if err := validateNegativeIndex(op, from); err != nil {
+		return err
+	}
+
+	// Return error if from is after to. Error reports length of list, like for any other index out of range.
+	if from > to {
+		return &IndexOutOfRangeError{Op: op, Index: from, Length: length}
+	}
+
+	return nil
+}
+
+// GetRange returns nodes from index from up to, but not including, index to.
+// Return error if from or to is negative, to is greater than length of List or from is greater than to.
+func (l *List[T]) GetRange(from, to int) ([]*Node[T], error) {
+	if err := validateRange("GetRange", from, to, l.length); err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*Node[T], 0, to-from)
+	for current := l.rangeStart(from, to); len(nodes) < to-from; current = current.next {
+		nodes = append(nodes, current)
+	}
+	return nodes, nil
+}
+
+// CopyRange returns new List with copies of values of nodes from index from up to, but not including, index to.
+// Return error if from or to is negative, to is greater than length of List or from is greater than to.
+func (l *List[T]) CopyRange(from, to int) (*List[T], error) {
+	if err := validateRange("CopyRange", from, to, l.length); err != nil {
+		return nil, err
+	}
+
+	other := &List[T]{}
+	for current := l.rangeStart(from, to); other.length < to-from; current = current.next {
+		other.PushBack(current.Value)
+	}
+	return other, nil
+}
+
+// DeleteRange deletes nodes from index from up to, but not including, index to, in one traversal.
+// Return error if from or to is negative, to is greater than length of List or from is greater than to.
+func (l *List[T]) DeleteRange(from, to int) error {
+	if err := validateRange("DeleteRange", from, to, l.length); err != nil {
+		return err
+	}
+
+	l.deleteAfter(l.rangeMark(from), to-from)
+	return nil
+}
+
+// ReplaceRange replaces nodes from index from up to, but not including, index to with new nodes holding passed values.
+// Number of values can differ from number of replaced nodes.
+// Return error if from or to is negative, to is greater than length of List or from is greater than to.
+func (l *List[T]) ReplaceRange(from, to int, values ...T) error {
+	if err := validateRange("ReplaceRange", from, to, l.length); err != nil {
+		return err
+	}
+
+	// Replacing is recorded as one change, so it can be undone in one step.
+	l.recordGroup(func() {
+		mark := l.rangeMark(from)
+		l.deleteAfter(mark, to-from)
+		l.insertValuesAfter(values, mark)
+	})
+	return nil
+}
+
+// Return first node of already validated range, or nil if range is empty.
+func (l *List[T]) rangeStart(from, to int) *Node[T] {
+	if from == to {
+		return nil
+	}
+	return l.nodeAt(from)
+}
+
+// Return node which precedes already validated index from, or nil if from is 0.
+func (l *List[T]) rangeMark(from int) *Node[T] {
+	if from == 0 {
+		return nil
+	}
+	return l.nodeAt(from - 1)
+}
+
+// Delete n nodes which follow mark. If mark is nil, nodes are deleted from the beginning of list.
+func (l *List[T]) deleteAfter(mark *Node[T], n int) {
+	if n == 0 {
+		return
+	}
+	first := l.head
+	if mark != nil {
+		first = mark.next
+	}
+
+	// Delete nodes one by one, so every deletion is reported, but undone in one step.
+	if l.recording() {
+		l.recordGroup(func() {
+			for range n {
+				next := first.next
+				l.deleteNode(first)
+				first = next
+			}
+		})
+		return
+	}
+
+	// Clear ownership of deleted nodes and find node which follows them.
+	next := first
+	for range n {
+		current := next
+		next = current.next
+		current.next, current.previous, current.list = nil, nil, nil
+		if l.arena != nil {
+			l.arena.release(current)
+		}
+	}
+
+	// Connect nodes around deleted nodes.
+	if mark == nil {
+		l.head = next
+	} else {
+		mark.next = next
+	}
+	if next == nil {
+		l.tail = mark
+	} else {
+		next.previous = mark
+	}
+	l.length -= n
+}
@@ -0,0 +1,179 @@
+package godll
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var invalidRangeTestCases = []struct {
+	name     string
+	from, to int
+	err      func(op string) error
+}{
+	{name: "Negative from", from: -1, to: 2, err: func(op string) error { return &NegativeIndexError{Op: op, Index: -1} }},
+	{name: "Negative to", from: 0, to: -2, err: func(op string) error { return &NegativeIndexError{Op: op, Index: -2} }},
+	{name: "To out of range", from: 1, to: 6, err: func(op string) error { return &IndexOutOfRangeError{Op: op, Index: 6, Length: 5} }},
+	{name: "From after to", from: 3, to: 2, err: func(op string) error { return &IndexOutOfRangeError{Op: op, Index: 3, Length: 5} }},
+	{name: "From far after to", from: 3, to: 1, err: func(op string) error { return &IndexOutOfRangeError{Op: op, Index: 3, Length: 5} }},
+}
+
+func TestGetRange(t *testing.T) {
+	t.Run("Valid range", func(t *testing.T) {
+		list, nodes := testListInt(5)
+		got, err := list.GetRange(1, 4)
+		assert.Nil(t, err)
+		assert.Equal(t, nodes[1:4], got)
+
+		got, err = list.GetRange(0, 5)
+		assert.Nil(t, err)
+		assert.Equal(t, nodes, got)
+
+		got, err = list.GetRange(5, 5)
+		assert.Nil(t, err)
+		assert.Empty(t, got)
+	})
+
+	for _, tc := range invalidRangeTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			list, _ := testListInt(5)
+			got, err := list.GetRange(tc.from, tc.to)
+			assert.Nil(t, got)
+			assert.Equal(t, tc.err("GetRange"), err)
+		})
+	}
+}
+
+func TestCopyRange(t *testing.T) {
+	t.Run("Valid range", func(t *testing.T) {
+		list, nodes := testListStruct(4)
+		other, err := list.CopyRange(2, 4)
+		assert.Nil(t, err)
+		forward, backward := listValues(other)
+		assert.Equal(t, []PersonTest{nodes[2].Value, nodes[3].Value}, forward)
+		assert.Equal(t, []PersonTest{nodes[2].Value, nodes[3].Value}, backward)
+		assert.NotSame(t, nodes[2], other.Head())
+		assert.Equal(t, 4, list.Length())
+		assert.Nil(t, other.Validate())
+
+		other, err = list.CopyRange(1, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, other.Length())
+	})
+
+	for _, tc := range invalidRangeTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			list, _ := testListInt(5)
+			other, err := list.CopyRange(tc.from, tc.to)
+			assert.Nil(t, other)
+			assert.Equal(t, tc.err("CopyRange"), err)
+		})
+	}
+}
+
+func TestDeleteRange(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from, to int
+		expected []int
+	}{
+		{name: "Beginning", from: 0, to: 2, expected: []int{3, 4, 5}},
+		{name: "Middle", from: 1, to: 4, expected: []int{1, 5}},
+		{name: "End", from: 3, to: 5, expected: []int{1, 2, 3}},
+		{name: "Whole list", from: 0, to: 5, expected: []int{}},
+		{name: "Empty range", from: 2, to: 2, expected: []int{1, 2, 3, 4, 5}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			list, nodes := testListInt(5)
+			assert.Nil(t, list.DeleteRange(tc.from, tc.to))
+			forward, backward := listValues(list)
+			assert.Equal(t, tc.expected, append([]int{}, forward...))
+			assert.Equal(t, tc.expected, append([]int{}, backward...))
+			assert.Equal(t, len(tc.expected), list.Length())
+			for _, node := range nodes[tc.from:tc.to] {
+				assert.Nil(t, node.List())
+				assert.Nil(t, node.Next())
+				assert.Nil(t, node.Previous())
+			}
+			assert.Nil(t, list.Validate())
+		})
+	}
+
+	for _, tc := range invalidRangeTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			list, _ := testListInt(5)
+			assert.Equal(t, tc.err("DeleteRange"), list.DeleteRange(tc.from, tc.to))
+			assert.Equal(t, 5, list.Length())
+		})
+	}
+
+	t.Run("Recorded", func(t *testing.T) {
+		list, nodes := testListInt(5)
+		list.EnableHistory(0)
+		events := recordEvents(list)
+		assert.Nil(t, list.DeleteRange(1, 3))
+		assert.Equal(t, []Event[int]{
+			{Kind: EventRemoved, Node: nodes[1], Index: 1, OldIndex: -1},
+			{Kind: EventRemoved, Node: nodes[2], Index: 1, OldIndex: -1},
+		}, *events)
+		assert.True(t, list.Undo())
+		assert.Equal(t, nodes, listNodes(list))
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Arena", func(t *testing.T) {
+		list := NewListWithArena[int](8)
+		list.AppendSlice([]int{1, 2, 3, 4})
+		assert.Nil(t, list.DeleteRange(1, 3))
+		assert.NotNil(t, list.arena.free)
+		assert.Equal(t, []int{1, 4}, list.ToSlice())
+		assert.Nil(t, list.Validate())
+	})
+}
+
+func TestReplaceRange(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from, to int
+		values   []int
+		expected []int
+	}{
+		{name: "Same length", from: 1, to: 3, values: []int{8, 9}, expected: []int{1, 8, 9, 4, 5}},
+		{name: "Shorter", from: 0, to: 3, values: []int{8}, expected: []int{8, 4, 5}},
+		{name: "Longer", from: 4, to: 5, values: []int{7, 8, 9}, expected: []int{1, 2, 3, 4, 7, 8, 9}},
+		{name: "No values", from: 1, to: 4, values: nil, expected: []int{1, 5}},
+		{name: "Empty range", from: 2, to: 2, values: []int{8}, expected: []int{1, 2, 8, 3, 4, 5}},
+		{name: "Empty range at end", from: 5, to: 5, values: []int{8}, expected: []int{1, 2, 3, 4, 5, 8}},
+		{name: "Whole list", from: 0, to: 5, values: []int{8}, expected: []int{8}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			list, _ := testListInt(5)
+			assert.Nil(t, list.ReplaceRange(tc.from, tc.to, tc.values...))
+			forward, backward := listValues(list)
+			assert.Equal(t, tc.expected, forward)
+			assert.Equal(t, tc.expected, backward)
+			assert.Nil(t, list.Validate())
+		})
+	}
+
+	for _, tc := range invalidRangeTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			list, _ := testListInt(5)
+			assert.Equal(t, tc.err("ReplaceRange"), list.ReplaceRange(tc.from, tc.to, 1))
+			assert.Equal(t, 5, list.Length())
+		})
+	}
+
+	t.Run("Undo", func(t *testing.T) {
+		list, nodes := testListInt(5)
+		list.EnableHistory(0)
+		assert.Nil(t, list.ReplaceRange(1, 4, 8, 9))
+		assert.Equal(t, []int{1, 8, 9, 5}, list.ToSlice())
+		assert.True(t, list.Undo())
+		assert.Equal(t, nodes, listNodes(list))
+		assert.False(t, list.Undo())
+		assert.Nil(t, list.Validate())
+	})
+}
@@ -0,0 +1,89 @@
+// Reversing and rotating doubly linked list.
+
+package godll
+
+// Reverse reverses order of nodes in List by relinking them, without allocation.
+func (l *List[T]) Reverse() {
+	if l.length < 2 {
+		return
+	}
+	l.reorder(func() {
+		l.reverseAfter(nil, l.length)
+	})
+}
+
+// ReverseRange reverses order of nodes from index from up to, but not including, index to, by relinking them.
+// Return error if from or to is negative, to is greater than length of List or from is greater than to.
+func (l *List[T]) ReverseRange(from, to int) error {
+	if err := validateRange("ReverseRange", from, to, l.length); err != nil {
+		return err
+	}
+	if to-from < 2 {
+		return nil
+	}
+
+	l.reorder(func() {
+		l.reverseAfter(l.rangeMark(from), to-from)
+	})
+	return nil
+}
+
+// Rotate moves last k nodes to the beginning of List by relinking them, without allocation.
+// If k is negative, first -k nodes are moved to the end of List instead. k can be greater than length of List.
+func (l *List[T]) Rotate(k int) {
+	if l.length < 2 {
+		return
+	}
+	// Normalize k to number of nodes moved from end to beginning.
+	k %= l.length
+	if k < 0 {
+		k += l.length
+	}
+	if k == 0 {
+		return
+	}
+
+	l.reorder(func() {
+		head := l.nodeAt(l.length - k)
+
+		// Close list into a ring and open it before new head.
+		l.tail.next = l.head
+		l.head.previous = l.tail
+		l.head, l.tail = head, head.previous
+		l.head.previous = nil
+		l.tail.next = nil
+	})
+}
+
+// Reverse n nodes which follow mark. If mark is nil, nodes are reversed from the beginning of list.
+func (l *List[T]) reverseAfter(mark *Node[T], n int) {
+	first := l.head
+	if mark != nil {
+		first = mark.next
+	}
+
+	// Swap links of every node in range. After the loop, current is node which follows range.
+	current := first
+	var last *Node[T]
+	for range n {
+		next := current.next
+		current.next, current.previous = current.previous, next
+		last = current
+		current = next
+	}
+
+	// Connect reversed range with its surroundings. Last node becomes first and first node becomes last.
+	if mark == nil {
+		l.head = last
+	} else {
+		mark.next = last
+	}
+	last.previous = mark
+
+	if current == nil {
+		l.tail = first
+	} else {
+		current.previous = first
+	}
+	first.next = current
+}
@@ -0,0 +1,132 @@
+package godll
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReverse(t *testing.T) {
+	testCases := []struct {
+		name string
+		n    int
+	}{
+		{name: "Empty list", n: 0},
+		{name: "One node", n: 1},
+		{name: "Two nodes", n: 2},
+		{name: "Five nodes", n: 5},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			list, nodes := testListInt(tc.n)
+			list.Reverse()
+			expected := slices.Clone(nodes)
+			slices.Reverse(expected)
+			assert.Equal(t, expected, append([]*Node[int]{}, listNodes(list)...))
+			forward, backward := listValues(list)
+			assert.Equal(t, forward, backward)
+			assert.Nil(t, list.Validate())
+		})
+	}
+}
+
+func TestReverseRange(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from, to int
+		expected []int
+	}{
+		{name: "Whole list", from: 0, to: 5, expected: []int{5, 4, 3, 2, 1}},
+		{name: "Beginning", from: 0, to: 3, expected: []int{3, 2, 1, 4, 5}},
+		{name: "Middle", from: 1, to: 4, expected: []int{1, 4, 3, 2, 5}},
+		{name: "End", from: 3, to: 5, expected: []int{1, 2, 3, 5, 4}},
+		{name: "One node", from: 2, to: 3, expected: []int{1, 2, 3, 4, 5}},
+		{name: "Empty range", from: 2, to: 2, expected: []int{1, 2, 3, 4, 5}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			list, _ := testListInt(5)
+			assert.Nil(t, list.ReverseRange(tc.from, tc.to))
+			forward, backward := listValues(list)
+			assert.Equal(t, tc.expected, forward)
+			assert.Equal(t, tc.expected, backward)
+			assert.Nil(t, list.Validate())
+		})
+	}
+
+	for _, tc := range invalidRangeTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			list, _ := testListInt(5)
+			assert.Equal(t, tc.err("ReverseRange"), list.ReverseRange(tc.from, tc.to))
+			assert.Equal(t, []int{1, 2, 3, 4, 5}, list.ToSlice())
+		})
+	}
+}
+
+func TestRotate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		k        int
+		expected []int
+	}{
+		{name: "Zero", k: 0, expected: []int{1, 2, 3, 4, 5}},
+		{name: "Positive", k: 2, expected: []int{4, 5, 1, 2, 3}},
+		{name: "Negative", k: -2, expected: []int{3, 4, 5, 1, 2}},
+		{name: "Length", k: 5, expected: []int{1, 2, 3, 4, 5}},
+		{name: "Greater than length", k: 6, expected: []int{5, 1, 2, 3, 4}},
+		{name: "Lower than negative length", k: -11, expected: []int{2, 3, 4, 5, 1}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			list, _ := testListInt(5)
+			list.Rotate(tc.k)
+			forward, backward := listValues(list)
+			assert.Equal(t, tc.expected, forward)
+			assert.Equal(t, tc.expected, backward)
+			assert.Nil(t, list.Validate())
+		})
+	}
+
+	t.Run("Short lists", func(t *testing.T) {
+		empty := &List[int]{}
+		empty.Rotate(3)
+		assert.Nil(t, empty.Validate())
+
+		list, _ := testListInt(1)
+		list.Rotate(-1)
+		assert.Equal(t, []int{1}, list.ToSlice())
+		assert.Nil(t, list.Validate())
+	})
+}
+
+func TestReorderRecorded(t *testing.T) {
+	list, nodes := testListInt(5)
+	list.EnableHistory(0)
+	events := recordEvents(list)
+
+	list.Reverse()
+	assert.Nil(t, list.ReverseRange(1, 4))
+	list.Rotate(-1)
+	assert.Equal(t, []int{2, 3, 4, 1, 5}, list.ToSlice())
+	assert.Equal(t, 3, len(*events))
+	assert.Equal(t, EventReordered, (*events)[2].Kind)
+
+	assert.True(t, list.Undo())
+	assert.True(t, list.Undo())
+	assert.True(t, list.Undo())
+	assert.Equal(t, nodes, listNodes(list))
+	assert.True(t, list.Redo())
+	assert.Equal(t, []int{5, 4, 3, 2, 1}, list.ToSlice())
+	assert.Nil(t, list.Validate())
+}
+
+func TestReorderAllocations(t *testing.T) {
+	list, _ := testListInt(100)
+	allocs := testing.AllocsPerRun(10, func() {
+		list.Reverse()
+		list.ReverseRange(10, 60)
+		list.Rotate(-7)
+	})
+	assert.Equal(t, 0.0, allocs)
+}
@@ -0,0 +1,102 @@
+// Ordered set backed by doubly linked list.
+
+package godll
+
+import "iter"
+
+// OrderedSet is set of unique values which keeps order in which values were added.
+// Values are stored in List and indexed by map, so Add, Contains and Remove take constant time.
+// Zero value is an empty set ready to use.
+type OrderedSet[T comparable] struct {
+	list  List[T]        // Values in insertion order.
+	nodes map[T]*Node[T] // Nodes of list indexed by their values.
+}
+
+// NewOrderedSet creates set containing passed values in passed order. Duplicate values are added only once.
+func NewOrderedSet[T comparable](values ...T) *OrderedSet[T] {
+	s := &OrderedSet[T]{nodes: make(map[T]*Node[T], len(values))}
+	for _, v := range values {
+		s.Add(v)
+	}
+	return s
+}
+
+// Len returns number of values in set.
+func (s *OrderedSet[T]) Len() int {
+	return s.list.Length()
+}
+
+// Contains reports whether value is in set.
+func (s *OrderedSet[T]) Contains(value T) bool {
+	_, ok := s.nodes[value]
+	return ok
+}
+
+// Add adds value to the end of set. Return false if value is already in set, in which case set is unchanged.
+func (s *OrderedSet[T]) Add(value T) bool {
+	if s.Contains(value) {
+		return false
+	}
+	if s.nodes == nil {
+		s.nodes = map[T]*Node[T]{}
+	}
+	s.nodes[value] = s.list.PushBack(value)
+	return true
+}
+
+// AddOrMove adds value to the end of set. If value is already in set, it is moved to the end.
+// Return true if value was added, or false if it was moved.
+func (s *OrderedSet[T]) AddOrMove(value T) bool {
+	if node, ok := s.nodes[value]; ok {
+		s.list.MoveToBack(node)
+		return false
+	}
+	return s.Add(value)
+}
+
+// Remove removes value from set. Return false if value is not in set.
+func (s *OrderedSet[T]) Remove(value T) bool {
+	node, ok := s.nodes[value]
+	if !ok {
+		return false
+	}
+	delete(s.nodes, value)
+	s.list.DeleteNode(node)
+	return true
+}
+
+// IndexOf returns position of value in insertion order, or -1 if value is not in set.
+// Index is counted by walking to the beginning of set, so IndexOf takes O(n) time.
+func (s *OrderedSet[T]) IndexOf(value T) int {
+	node, ok := s.nodes[value]
+	if !ok {
+		return -1
+	}
+	i := 0
+	for current := node.previous; current != nil; current = current.previous {
+		i++
+	}
+	return i
+}
+
+// Clear removes all values from set.
+func (s *OrderedSet[T]) Clear() {
+	s.list.Clear()
+	clear(s.nodes)
+}
+
+// Values returns iterator over all values in insertion order.
+func (s *OrderedSet[T]) Values() iter.Seq[T] {
+	return s.list.Values()
+}
+
+// Backward returns iterator over all values in reverse insertion order.
+func (s *OrderedSet[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s.list.Backward() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
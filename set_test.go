@@ -0,0 +1,73 @@
+package godll
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderedSet(t *testing.T) {
+	t.Run("Zero value", func(t *testing.T) {
+		s := &OrderedSet[string]{}
+		assert.Equal(t, 0, s.Len())
+		assert.False(t, s.Contains("a"))
+		assert.False(t, s.Remove("a"))
+		assert.Equal(t, -1, s.IndexOf("a"))
+		assert.True(t, s.Add("a"))
+		assert.True(t, s.Contains("a"))
+	})
+
+	t.Run("Add", func(t *testing.T) {
+		s := NewOrderedSet(3, 1, 3, 2)
+		assert.Equal(t, 3, s.Len())
+		assert.False(t, s.Add(1))
+		assert.True(t, s.Add(4))
+		assert.Equal(t, []int{3, 1, 2, 4}, slices.Collect(s.Values()))
+		assert.Equal(t, []int{4, 2, 1, 3}, slices.Collect(s.Backward()))
+		assert.Nil(t, s.list.Validate())
+	})
+
+	t.Run("AddOrMove", func(t *testing.T) {
+		s := NewOrderedSet(1, 2, 3)
+		assert.False(t, s.AddOrMove(1))
+		assert.True(t, s.AddOrMove(4))
+		assert.Equal(t, []int{2, 3, 1, 4}, slices.Collect(s.Values()))
+		assert.Nil(t, s.list.Validate())
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		s := NewOrderedSet("a", "b", "c")
+		assert.True(t, s.Remove("b"))
+		assert.False(t, s.Remove("b"))
+		assert.False(t, s.Contains("b"))
+		assert.Equal(t, []string{"a", "c"}, slices.Collect(s.Values()))
+		assert.True(t, s.Add("b"))
+		assert.Equal(t, []string{"a", "c", "b"}, slices.Collect(s.Values()))
+		assert.Nil(t, s.list.Validate())
+	})
+
+	t.Run("IndexOf", func(t *testing.T) {
+		s := NewOrderedSet("a", "b", "c")
+		assert.Equal(t, 0, s.IndexOf("a"))
+		assert.Equal(t, 2, s.IndexOf("c"))
+		assert.Equal(t, -1, s.IndexOf("d"))
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		s := NewOrderedSet(1, 2, 3)
+		s.Clear()
+		assert.Equal(t, 0, s.Len())
+		assert.False(t, s.Contains(1))
+		assert.Empty(t, slices.Collect(s.Values()))
+		assert.True(t, s.Add(1))
+	})
+
+	t.Run("Early stop", func(t *testing.T) {
+		s := NewOrderedSet(1, 2, 3)
+		for v := range s.Backward() {
+			assert.Equal(t, 3, v)
+			break
+		}
+	})
+}
@@ -0,0 +1,122 @@
+// Conversions between doubly linked list and slices.
+
+package godll
+
+import "iter"
+
+// FromSlice creates new List with node for every value in slice, in the same order.
+func FromSlice[T any](values []T) *List[T] {
+	l := &List[T]{}
+	l.AppendSlice(values)
+	return l
+}
+
+// FromValues creates new List with node for every passed value, in the same order.
+func FromValues[T any](values ...T) *List[T] {
+	return FromSlice(values)
+}
+
+// FromSeq creates new List with node for every value yielded by seq, in the same order.
+func FromSeq[T any](seq iter.Seq[T]) *List[T] {
+	l := &List[T]{}
+	for v := range seq {
+		l.PushBack(v)
+	}
+	return l
+}
+
+// ToSlice returns values of all nodes, starting from head.
+func (l *List[T]) ToSlice() []T {
+	values := make([]T, 0, l.length)
+	for current := l.head; current != nil; current = current.next {
+		values = append(values, current.Value)
+	}
+	return values
+}
+
+// ToSliceReverse returns values of all nodes, starting from tail.
+func (l *List[T]) ToSliceReverse() []T {
+	values := make([]T, 0, l.length)
+	for current := l.tail; current != nil; current = current.previous {
+		values = append(values, current.Value)
+	}
+	return values
+}
+
+// AppendSlice creates new node for every value in slice and adds them to the end of the List, in the same order.
+func (l *List[T]) AppendSlice(values []T) {
+	l.insertValuesAfter(values, l.tail)
+}
+
+// PrependSlice creates new node for every value in slice and adds them to the beggining of the List, in the same order.
+func (l *List[T]) PrependSlice(values []T) {
+	l.insertValuesAfter(values, nil)
+}
+
+// InsertSliceAt creates new node for every value in slice and inserts them at specific position, in the same order.
+// First value is at index after insertion.
+func (l *List[T]) InsertSliceAt(index int, values []T) error {
+	if err := validateInsertableIndex("InsertSliceAt", index, l.length); err != nil {
+		return err
+	}
+
+	var mark *Node[T]
+	if index > 0 {
+		mark = l.nodeAt(index - 1)
+	}
+	l.insertValuesAfter(values, mark)
+	return nil
+}
+
+// Create nodes for values and link them after mark in one pass. If mark is nil, nodes are added to the beginning of list.
+func (l *List[T]) insertValuesAfter(values []T, mark *Node[T]) {
+	if len(values) == 0 {
+		return
+	}
+
+	// Insert nodes one by one, so every insertion is reported, but undone in one step.
+	if l.recording() {
+		l.recordGroup(func() {
+			for _, v := range values {
+				node := l.NewNode(v)
+				l.insertAfter(node, mark)
+				mark = node
+			}
+		})
+		return
+	}
+
+	// Link new nodes into chain.
+	var first, last *Node[T]
+	for _, v := range values {
+		node := l.NewNode(v)
+		node.list = l
+		node.previous = last
+		if last == nil {
+			first = node
+		} else {
+			last.next = node
+		}
+		last = node
+	}
+
+	// Connect chain between mark and node which followed it.
+	var next *Node[T]
+	if mark == nil {
+		next = l.head
+		l.head = first
+	} else {
+		next = mark.next
+		mark.next = first
+	}
+	first.previous = mark
+
+	if next == nil {
+		l.tail = last
+	} else {
+		next.previous = last
+	}
+	last.next = next
+
+	l.length += len(values)
+}
@@ -0,0 +1,150 @@
+package godll
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromSlice(t *testing.T) {
+	t.Run("Empty slice", func(t *testing.T) {
+		list := FromSlice([]int{})
+		assert.Equal(t, 0, list.Length())
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Int", func(t *testing.T) {
+		list := FromSlice([]int{1, 2, 3})
+		forward, backward := listValues(list)
+		assert.Equal(t, []int{1, 2, 3}, forward)
+		assert.Equal(t, []int{1, 2, 3}, backward)
+		assert.Equal(t, 3, list.Length())
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("FromValues", func(t *testing.T) {
+		list := FromValues("a", "b")
+		forward, _ := listValues(list)
+		assert.Equal(t, []string{"a", "b"}, forward)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("FromSeq", func(t *testing.T) {
+		list := FromSeq(slices.Values([]float64{1.5, 2.5}))
+		forward, _ := listValues(list)
+		assert.Equal(t, []float64{1.5, 2.5}, forward)
+
+		empty := FromSeq(maps.Keys(map[int]bool{}))
+		assert.Equal(t, 0, empty.Length())
+		assert.Nil(t, empty.Validate())
+	})
+}
+
+func TestToSlice(t *testing.T) {
+	list, _ := testListInt(3)
+	assert.Equal(t, []int{1, 2, 3}, list.ToSlice())
+	assert.Equal(t, []int{3, 2, 1}, list.ToSliceReverse())
+	assert.Equal(t, []int{}, (&List[int]{}).ToSlice())
+	assert.Equal(t, []int{}, (&List[int]{}).ToSliceReverse())
+
+	people, nodes := testListStruct(2)
+	assert.Equal(t, []PersonTest{nodes[1].Value, nodes[0].Value}, people.ToSliceReverse())
+}
+
+func TestAppendSlice(t *testing.T) {
+	t.Run("Empty list", func(t *testing.T) {
+		list := &List[int]{}
+		list.AppendSlice([]int{1, 2})
+		forward, backward := listValues(list)
+		assert.Equal(t, []int{1, 2}, forward)
+		assert.Equal(t, []int{1, 2}, backward)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Nonempty list", func(t *testing.T) {
+		list, _ := testListInt(2)
+		list.AppendSlice([]int{3, 4})
+		list.AppendSlice(nil)
+		forward, backward := listValues(list)
+		assert.Equal(t, []int{1, 2, 3, 4}, forward)
+		assert.Equal(t, []int{1, 2, 3, 4}, backward)
+		assert.Equal(t, 4, list.Length())
+		assert.Nil(t, list.Validate())
+	})
+}
+
+func TestPrependSlice(t *testing.T) {
+	list, _ := testListInt(2)
+	list.PrependSlice([]int{-1, 0})
+	forward, backward := listValues(list)
+	assert.Equal(t, []int{-1, 0, 1, 2}, forward)
+	assert.Equal(t, []int{-1, 0, 1, 2}, backward)
+	assert.Nil(t, list.Validate())
+
+	empty := &List[int]{}
+	empty.PrependSlice([]int{1})
+	assert.Equal(t, empty.Head(), empty.Tail())
+	assert.Nil(t, empty.Validate())
+}
+
+func TestInsertSliceAt(t *testing.T) {
+	testCases := []struct {
+		name     string
+		index    int
+		expected []int
+	}{
+		{name: "Beginning", index: 0, expected: []int{8, 9, 1, 2, 3}},
+		{name: "Middle", index: 2, expected: []int{1, 2, 8, 9, 3}},
+		{name: "End", index: 3, expected: []int{1, 2, 3, 8, 9}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			list, _ := testListInt(3)
+			assert.Nil(t, list.InsertSliceAt(tc.index, []int{8, 9}))
+			forward, backward := listValues(list)
+			assert.Equal(t, tc.expected, forward)
+			assert.Equal(t, tc.expected, backward)
+			assert.Nil(t, list.Validate())
+		})
+	}
+
+	t.Run("Invalid index", func(t *testing.T) {
+		list, _ := testListInt(3)
+		assert.Equal(t, &IndexOutOfRangeError{Op: "InsertSliceAt", Index: 4, Length: 3}, list.InsertSliceAt(4, []int{1}))
+		assert.Equal(t, &NegativeIndexError{Op: "InsertSliceAt", Index: -1}, list.InsertSliceAt(-1, []int{1}))
+		assert.Equal(t, 3, list.Length())
+	})
+
+	t.Run("Recorded", func(t *testing.T) {
+		list, _ := testListInt(3)
+		list.EnableHistory(0)
+		events := recordEvents(list)
+		assert.Nil(t, list.InsertSliceAt(1, []int{8, 9}))
+		assert.Equal(t, 2, len(*events))
+		assert.Equal(t, 2, (*events)[1].Index)
+		assert.True(t, list.Undo())
+		assert.Equal(t, []int{1, 2, 3}, list.ToSlice())
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Arena", func(t *testing.T) {
+		list := NewListWithArena[int](4)
+		list.AppendSlice([]int{1, 2, 3})
+		// Three nodes were taken from chunk of four nodes.
+		assert.Equal(t, 1, len(list.arena.chunk))
+		assert.Nil(t, list.Validate())
+	})
+}
+
+func BenchmarkAppendSlice(b *testing.B) {
+	for _, tc := range benchmarkTestCases[:2] {
+		values := make([]int, tc.n)
+		b.Run(tc.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				FromSlice(values)
+			}
+		})
+	}
+}
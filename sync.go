@@ -0,0 +1,207 @@
+// Concurrency-safe doubly linked list.
+
+package godll
+
+import (
+	"io"
+	"sync"
+)
+
+// SyncList is List safe for concurrent use by multiple goroutines.
+// Reading methods hold read lock, while mutating methods hold write lock.
+// Zero value is an empty list ready to use.
+// Returned nodes are shared with wrapped list, so their Value, Next, Previous and List may be read only inside Do,
+// while lock is held. Outside Do, use methods which return values, like ValueAt, IndexOf and ToSlice.
+type SyncList[T any] struct {
+	mu   sync.RWMutex // Lock guarding list.
+	list List[T]      // Wrapped list.
+}
+
+// Head returns first node in list. Returned node may be read only inside Do.
+func (s *SyncList[T]) Head() *Node[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.Head()
+}
+
+// Tail returns last node in list. Returned node may be read only inside Do.
+func (s *SyncList[T]) Tail() *Node[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.Tail()
+}
+
+// Length returns number of nodes in list.
+func (s *SyncList[T]) Length() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.Length()
+}
+
+// Print prints all elements in a list using passed io.Writer interface.
+func (s *SyncList[T]) Print(w io.Writer) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	s.list.Print(w)
+}
+
+// Append adds node to the end of the list. Return error if node is nil or already belongs to a list.
+func (s *SyncList[T]) Append(node *Node[T]) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.Append(node)
+}
+
+// Prepend adds node to the beggining of the list. Return error if node is nil or already belongs to a list.
+func (s *SyncList[T]) Prepend(node *Node[T]) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.Prepend(node)
+}
+
+// InsertAt inserts new node at specific position. Return error if node is nil or already belongs to a list.
+func (s *SyncList[T]) InsertAt(index int, node *Node[T]) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.InsertAt(index, node)
+}
+
+// GetByIndex retrieves node by index. Return error if index is out of range. Index of first node is 0.
+// Returned node may be read only inside Do.
+func (s *SyncList[T]) GetByIndex(index int) (*Node[T], error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.GetByIndex(index)
+}
+
+// GetByValue returns index of node and node with passed value using compare function compFunc.
+// If compFunc is nil, use default comparison with "==". Returns -1 and nil if there is no node with given value in list.
+// Returned node may be read only inside Do.
+func (s *SyncList[T]) GetByValue(value T, compFunc fun[T]) (int, *Node[T]) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.GetByValue(value, compFunc)
+}
+
+// GetAllValues return map with indexes and nodes of all nodes with passed value using compare function compFunc.
+// If compFunc is nil, use default comparison with "==". Returns empty map if there is no node with given value in list.
+// Returned nodes may be read only inside Do.
+func (s *SyncList[T]) GetAllValues(value T, compFunc fun[T]) map[int]*Node[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.GetAllValues(value, compFunc)
+}
+
+// ValueAt returns value of node at passed index. Return error if index is out of range. Index of first node is 0.
+func (s *SyncList[T]) ValueAt(index int) (T, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	node, err := s.list.GetByIndex(index)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return node.Value, nil
+}
+
+// IndexOf returns index of first node with passed value using compare function compFunc.
+// If compFunc is nil, use default comparison with "==". Returns -1 if there is no node with given value in list.
+func (s *SyncList[T]) IndexOf(value T, compFunc fun[T]) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	index, _ := s.list.GetByValue(value, compFunc)
+	return index
+}
+
+// ToSlice returns values of all nodes, starting from head.
+func (s *SyncList[T]) ToSlice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.ToSlice()
+}
+
+// Swap changes places of nodes on passed positions.
+func (s *SyncList[T]) Swap(i, j int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.Swap(i, j)
+}
+
+// DeleteAt deletes node at given index.
+func (s *SyncList[T]) DeleteAt(index int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.DeleteAt(index)
+}
+
+// DeleteNode deletes passed node from list. Return error if node doesn't belong to list.
+func (s *SyncList[T]) DeleteNode(node *Node[T]) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.DeleteNode(node)
+}
+
+// DeleteValues deletes all nodes with passed value using compare function compFunc.
+// If compFunc is nil, use default comparison with "==". Return number of deleted nodes.
+func (s *SyncList[T]) DeleteValues(value T, compFunc fun[T]) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.DeleteValues(value, compFunc)
+}
+
+// Sort sorts nodes in list with sorting function sortFunc. See List.Sort.
+func (s *SyncList[T]) Sort(sortFunc fun[T]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.Sort(sortFunc)
+}
+
+// PushFront creates new node with passed value and adds it to the beggining of the list. Return pointer to created node. Returned node may be read only inside Do.
+func (s *SyncList[T]) PushFront(value T) *Node[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.PushFront(value)
+}
+
+// PushBack creates new node with passed value and adds it to the end of the list. Return pointer to created node. Returned node may be read only inside Do.
+func (s *SyncList[T]) PushBack(value T) *Node[T] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.PushBack(value)
+}
+
+// PopFront deletes first node in list and returns its value. Return zero value and false if list is empty.
+func (s *SyncList[T]) PopFront() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.PopFront()
+}
+
+// PopBack deletes last node in list and returns its value. Return zero value and false if list is empty.
+func (s *SyncList[T]) PopBack() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.PopBack()
+}
+
+// PeekFront returns value of first node in list. Return zero value and false if list is empty.
+func (s *SyncList[T]) PeekFront() (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.PeekFront()
+}
+
+// PeekBack returns value of last node in list. Return zero value and false if list is empty.
+func (s *SyncList[T]) PeekBack() (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.PeekBack()
+}
+
+// Do calls f with wrapped list while holding write lock, so multiple operations can be done atomically.
+// Wrapped list must not be retained or used after f returns.
+func (s *SyncList[T]) Do(f func(*List[T])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f(&s.list)
+}
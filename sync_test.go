@@ -0,0 +1,187 @@
+package godll
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncList(t *testing.T) {
+	t.Run("Empty list", func(t *testing.T) {
+		list := &SyncList[int]{}
+		assert.Nil(t, list.Head())
+		assert.Nil(t, list.Tail())
+		assert.Equal(t, 0, list.Length())
+	})
+
+	t.Run("Operations", func(t *testing.T) {
+		list, nodes := &SyncList[int]{}, testNodesInt(5)
+		assert.Nil(t, list.Append(nodes[1]))
+		assert.Nil(t, list.Prepend(nodes[0]))
+		assert.Nil(t, list.InsertAt(2, nodes[3]))
+		assert.Nil(t, list.InsertAt(2, nodes[2]))
+		assert.Nil(t, list.Append(nodes[4]))
+		assert.Equal(t, &NodeAlreadyInListError[int]{Op: "Append", Node: nodes[4]}, list.Append(nodes[4]))
+		assert.Equal(t, 5, list.Length())
+		assert.Equal(t, nodes[0], list.Head())
+		assert.Equal(t, nodes[4], list.Tail())
+
+		retrieved, err := list.GetByIndex(3)
+		assert.Nil(t, err)
+		assert.Equal(t, nodes[3], retrieved)
+		i, retrieved := list.GetByValue(nodes[2].Value, nil)
+		assert.Equal(t, 2, i)
+		assert.Equal(t, nodes[2], retrieved)
+		assert.Equal(t, map[int]*Node[int]{1: nodes[1]}, list.GetAllValues(nodes[1].Value, nil))
+
+		assert.Nil(t, list.Swap(0, 4))
+		list.Sort(func(v1, v2 int) bool { return v1 < v2 })
+		var output bytes.Buffer
+		list.Print(&output)
+		assert.Equal(t, "1 2 3 4 5\n", output.String())
+
+		assert.Nil(t, list.DeleteAt(0))
+		assert.Nil(t, list.DeleteNode(nodes[4]))
+		assert.Equal(t, 1, list.DeleteValues(nodes[2].Value, nil))
+		assert.Equal(t, 2, list.Length())
+		assert.Equal(t, nodes[1], list.Head())
+		assert.Equal(t, nodes[3], list.Tail())
+	})
+
+	t.Run("Deque", func(t *testing.T) {
+		list := &SyncList[int]{}
+		list.PushBack(2)
+		list.PushFront(1)
+		list.PushBack(3)
+		v, ok := list.PeekFront()
+		assert.True(t, ok)
+		assert.Equal(t, 1, v)
+		v, ok = list.PeekBack()
+		assert.True(t, ok)
+		assert.Equal(t, 3, v)
+		v, ok = list.PopFront()
+		assert.True(t, ok)
+		assert.Equal(t, 1, v)
+		v, ok = list.PopBack()
+		assert.True(t, ok)
+		assert.Equal(t, 3, v)
+		assert.Equal(t, 1, list.Length())
+	})
+
+	t.Run("Values", func(t *testing.T) {
+		list := &SyncList[int]{}
+		list.PushBack(1)
+		list.PushBack(2)
+		v, err := list.ValueAt(1)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, v)
+		_, err = list.ValueAt(2)
+		assert.Equal(t, &IndexOutOfRangeError{Op: "GetByIndex", Index: 2, Length: 2}, err)
+		assert.Equal(t, 1, list.IndexOf(2, nil))
+		assert.Equal(t, -1, list.IndexOf(3, nil))
+		assert.Equal(t, []int{1, 2}, list.ToSlice())
+	})
+
+	t.Run("Do", func(t *testing.T) {
+		list := &SyncList[int]{}
+		list.Do(func(l *List[int]) {
+			l.Append(NewNode(1))
+			l.Append(NewNode(2))
+		})
+		assert.Equal(t, 2, list.Length())
+	})
+}
+
+func TestSyncListConcurrent(t *testing.T) {
+	list := &SyncList[int]{}
+	goroutines, n := 8, 200
+	var wg sync.WaitGroup
+
+	for g := 0; g < goroutines; g++ {
+		wg.Add(2)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < n; i++ {
+				if i%2 == 0 {
+					list.Append(NewNode(g*n + i))
+				} else {
+					list.Prepend(NewNode(g*n + i))
+				}
+				list.InsertAt(list.Length()/2, NewNode(-1))
+				list.DeleteValues(-1, nil)
+			}
+		}(g)
+
+		go func() {
+			defer wg.Done()
+			var output bytes.Buffer
+			for i := 0; i < n; i++ {
+				list.GetByIndex(0)
+				list.GetByValue(i, nil)
+				list.Print(&output)
+				output.Reset()
+				// Compound operation has to see consistent list.
+				list.Do(func(l *List[int]) {
+					c := 0
+					for range l.All() {
+						c++
+					}
+					assert.Equal(t, l.Length(), c)
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, goroutines*n, list.Length())
+	list.Sort(func(v1, v2 int) bool { return v1 < v2 })
+	list.Do(func(l *List[int]) {
+		for i, v := range l.All() {
+			assert.Equal(t, i, v)
+		}
+	})
+}
+
+func TestSyncListReturnedNode(t *testing.T) {
+	list := &SyncList[int]{}
+	for i := 0; i < 10; i++ {
+		list.PushBack(i)
+	}
+	head := list.Head()
+	n := 200
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < n; i++ {
+			list.InsertAt(1, NewNode(-1))
+			list.DeleteValues(-1, nil)
+			list.Swap(1, 2)
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < n; i++ {
+			// Returned node is walked only while lock is held.
+			list.Do(func(l *List[int]) {
+				c := 0
+				for node := head; node != nil; node = node.Next() {
+					c++
+				}
+				assert.Equal(t, l.Length(), c)
+			})
+			_, err := list.ValueAt(0)
+			assert.Nil(t, err)
+			list.ToSlice()
+		}
+	}()
+	wg.Wait()
+
+	v, err := list.ValueAt(0)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, v)
+}
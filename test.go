@@ -105,6 +105,31 @@ func testListStruct(n int) (*List[PersonTest], []*Node[PersonTest]) {
 	return list, nodes
 }
 
+// Create slice of non-comparable test nodes holding slices.
+func testNodesSlice(n int) []*Node[[]int] {
+	nodes := []*Node[[]int]{}
+	for i := 1; i <= n; i++ {
+		node := NewNode([]int{i, i * 10})
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// Create non-comparable test list with test nodes.
+func testListSlice(n int) (*List[[]int], []*Node[[]int]) {
+	list := &List[[]int]{}
+	nodes := testNodesSlice(n)
+	for _, node := range nodes {
+		list.Append(node)
+	}
+	return list, nodes
+}
+
+// Compare slices of ints by their first element.
+func equalFirst(v1, v2 []int) bool {
+	return v1[0] == v2[0]
+}
+
 func generateRandomList(n int) *List[int] {
 	list := &List[int]{}
 	for _, random := range rand.Perm(n) {
@@ -112,3 +137,15 @@ func generateRandomList(n int) *List[int] {
 	}
 	return list
 }
+
+// Collect values of list nodes from head to tail, and from tail to head.
+func listValues[T any](list *List[T]) ([]T, []T) {
+	forward, backward := []T{}, []T{}
+	for current := list.head; current != nil; current = current.next {
+		forward = append(forward, current.Value)
+	}
+	for current := list.tail; current != nil; current = current.previous {
+		backward = append([]T{current.Value}, backward...)
+	}
+	return forward, backward
+}
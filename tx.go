@@ -0,0 +1,176 @@
+// Transactional batch mutations of doubly linked list.
+
+package godll
+
+import "errors"
+
+// Tx is transaction over List, created by List.Tx. All mutations done through Tx are recorded,
+// so they can be rolled back if transaction fails. Tx must not be used after transaction function returns.
+type Tx[T any] struct {
+	list     *List[T]    // List on which transaction is done.
+	changes  []change[T] // Changes made in transaction, in order in which they were made.
+	discards int         // Number of discards of list changes when transaction started.
+}
+
+// Tx calls f with transaction over list and returns error returned by f.
+// If f returns error or panics, all changes made in transaction are rolled back in reverse order,
+// restoring exact nodes, their order and values of Head, Tail and Length. Panic is propagated after rollback.
+// Changes made directly on list inside f are recorded and rolled back as well.
+// SplitAt, Splice, MergeSorted and MergeK can't be rolled back. If any of them changes list inside f,
+// changes are committed instead of rolled back, and RollbackError is joined to error returned by f.
+// If node deleted in transaction was meanwhile added to other list, changes can't be rolled back, so they are committed
+// instead, and NodeAlreadyInListError is joined to error returned by f.
+// Transactions can be nested, in which case inner transaction is committed as part of outer transaction.
+// Nodes deleted in transaction are never recycled by arena, even after transaction is committed.
+func (l *List[T]) Tx(f func(tx *Tx[T]) error) (err error) {
+	tx := &Tx[T]{list: l, discards: l.discards}
+	outer := l.recorder
+	l.recorder = func(c change[T]) {
+		tx.changes = append(tx.changes, c)
+	}
+
+	defer func() {
+		l.recorder = outer
+		if r := recover(); r != nil {
+			tx.rollback(outer)
+			panic(r)
+		}
+		if err != nil {
+			if rollbackErr := tx.rollback(outer); rollbackErr != nil {
+				err = errors.Join(err, rollbackErr)
+			}
+			return
+		}
+		tx.commit(outer)
+	}()
+
+	return f(tx)
+}
+
+// Undo all changes in reverse order. Return error and commit changes if list was split, spliced or merged,
+// or if changes would link node which belongs to other list.
+func (tx *Tx[T]) rollback(outer func(change[T])) error {
+	if tx.list.discards != tx.discards {
+		tx.commit(outer)
+		return &RollbackError{Op: "Tx"}
+	}
+	changes := groupChange[T](tx.changes)
+	if node := tx.list.foreignNode(changes); node != nil {
+		tx.commit(outer)
+		return &NodeAlreadyInListError[T]{Op: "Tx", Node: node}
+	}
+
+	changes.undo(tx.list)
+	tx.changes = nil
+	return nil
+}
+
+// Pass changes to outer recorder as one change, if there is one.
+// Changes are dropped if list changes were discarded meanwhile, because outer recorder can't undo them either.
+func (tx *Tx[T]) commit(outer func(change[T])) {
+	if outer != nil && len(tx.changes) > 0 && tx.list.discards == tx.discards {
+		outer(groupChange[T](tx.changes))
+	}
+	tx.changes = nil
+}
+
+// Head returns first node in list.
+func (tx *Tx[T]) Head() *Node[T] {
+	return tx.list.Head()
+}
+
+// Tail returns last node in list.
+func (tx *Tx[T]) Tail() *Node[T] {
+	return tx.list.Tail()
+}
+
+// Length returns number of nodes in list.
+func (tx *Tx[T]) Length() int {
+	return tx.list.Length()
+}
+
+// GetByIndex retrieves node by index. Return error if index is out of range. Index of first node is 0.
+func (tx *Tx[T]) GetByIndex(index int) (*Node[T], error) {
+	return tx.list.GetByIndex(index)
+}
+
+// Append adds node to the end of the list. Return error if node is nil or already belongs to a list.
+func (tx *Tx[T]) Append(node *Node[T]) error {
+	return tx.list.Append(node)
+}
+
+// Prepend adds node to the beggining of the list. Return error if node is nil or already belongs to a list.
+func (tx *Tx[T]) Prepend(node *Node[T]) error {
+	return tx.list.Prepend(node)
+}
+
+// InsertAt inserts new node at specific position. Return error if node is nil or already belongs to a list.
+func (tx *Tx[T]) InsertAt(index int, node *Node[T]) error {
+	return tx.list.InsertAt(index, node)
+}
+
+// PushFront creates new node with passed value and adds it to the beggining of the list. Return pointer to created node.
+func (tx *Tx[T]) PushFront(value T) *Node[T] {
+	return tx.list.PushFront(value)
+}
+
+// PushBack creates new node with passed value and adds it to the end of the list. Return pointer to created node.
+func (tx *Tx[T]) PushBack(value T) *Node[T] {
+	return tx.list.PushBack(value)
+}
+
+// PopFront deletes first node in list and returns its value. Return zero value and false if list is empty.
+func (tx *Tx[T]) PopFront() (T, bool) {
+	return tx.list.PopFront()
+}
+
+// PopBack deletes last node in list and returns its value. Return zero value and false if list is empty.
+func (tx *Tx[T]) PopBack() (T, bool) {
+	return tx.list.PopBack()
+}
+
+// Swap changes places of nodes on passed positions.
+func (tx *Tx[T]) Swap(i, j int) error {
+	return tx.list.Swap(i, j)
+}
+
+// MoveToFront moves node to the beginning of the list in constant time. Return error if node doesn't belong to list.
+func (tx *Tx[T]) MoveToFront(node *Node[T]) error {
+	return tx.list.MoveToFront(node)
+}
+
+// MoveToBack moves node to the end of the list in constant time. Return error if node doesn't belong to list.
+func (tx *Tx[T]) MoveToBack(node *Node[T]) error {
+	return tx.list.MoveToBack(node)
+}
+
+// MoveBefore moves node in front of mark node in constant time. Return error if node or mark doesn't belong to list.
+func (tx *Tx[T]) MoveBefore(node, mark *Node[T]) error {
+	return tx.list.MoveBefore(node, mark)
+}
+
+// MoveAfter moves node behind mark node in constant time. Return error if node or mark doesn't belong to list.
+func (tx *Tx[T]) MoveAfter(node, mark *Node[T]) error {
+	return tx.list.MoveAfter(node, mark)
+}
+
+// DeleteAt deletes node at given index.
+func (tx *Tx[T]) DeleteAt(index int) error {
+	return tx.list.DeleteAt(index)
+}
+
+// DeleteNode deletes passed node from list. Return error if node doesn't belong to list.
+func (tx *Tx[T]) DeleteNode(node *Node[T]) error {
+	return tx.list.DeleteNode(node)
+}
+
+// DeleteValues deletes all nodes with passed value using compare function compFunc.
+// If compFunc is nil, use default comparison with "==". Return number of deleted nodes.
+func (tx *Tx[T]) DeleteValues(value T, compFunc fun[T]) int {
+	return tx.list.DeleteValues(value, compFunc)
+}
+
+// Sort sorts nodes in list with sorting function sortFunc. See List.Sort.
+func (tx *Tx[T]) Sort(sortFunc fun[T]) {
+	tx.list.Sort(sortFunc)
+}
@@ -0,0 +1,293 @@
+package godll
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Return nodes of list in order from head to tail.
+func listNodes[T any](list *List[T]) []*Node[T] {
+	var nodes []*Node[T]
+	for node := range list.Nodes() {
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+func TestTx(t *testing.T) {
+	errTest := errors.New("test error")
+
+	t.Run("Commit", func(t *testing.T) {
+		list, nodes := testListInt(3)
+		err := list.Tx(func(tx *Tx[int]) error {
+			tx.PushBack(4)
+			tx.PushFront(0)
+			assert.Nil(t, tx.DeleteNode(nodes[1]))
+			return tx.Swap(0, 3)
+		})
+		assert.Nil(t, err)
+		forward, backward := listValues(list)
+		assert.Equal(t, []int{4, 1, 3, 0}, forward)
+		assert.Equal(t, []int{4, 1, 3, 0}, backward)
+		assert.Nil(t, nodes[1].List())
+		assert.Nil(t, list.recorder)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Rollback on error", func(t *testing.T) {
+		list, nodes := testListInt(5)
+		before := listNodes(list)
+		inserted := NewNode(100)
+		err := list.Tx(func(tx *Tx[int]) error {
+			assert.Nil(t, tx.Append(NewNode(6)))
+			assert.Nil(t, tx.Prepend(NewNode(0)))
+			assert.Nil(t, tx.InsertAt(3, inserted))
+			assert.Nil(t, tx.DeleteAt(1))
+			assert.Nil(t, tx.DeleteNode(nodes[4]))
+			tx.PopFront()
+			tx.PopBack()
+			assert.Nil(t, tx.Swap(0, 2))
+			assert.Nil(t, tx.Swap(1, 2))
+			assert.Nil(t, tx.MoveToFront(nodes[3]))
+			assert.Nil(t, tx.MoveToBack(nodes[2]))
+			assert.Nil(t, tx.MoveBefore(nodes[2], nodes[3]))
+			assert.Nil(t, tx.MoveAfter(inserted, nodes[2]))
+			tx.PushBack(3)
+			assert.Equal(t, 2, tx.DeleteValues(3, nil))
+			tx.Sort(func(v1, v2 int) bool { return v1 > v2 })
+			return errTest
+		})
+		assert.Equal(t, errTest, err)
+		assert.Equal(t, before, listNodes(list))
+		assert.Equal(t, nodes[0], list.Head())
+		assert.Equal(t, nodes[4], list.Tail())
+		assert.Equal(t, 5, list.Length())
+		for _, node := range nodes {
+			assert.Equal(t, list, node.List())
+		}
+		assert.Nil(t, inserted.List())
+		assert.Nil(t, inserted.Next())
+		assert.Nil(t, inserted.Previous())
+		assert.Nil(t, list.recorder)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Rollback on panic", func(t *testing.T) {
+		list, _ := testListInt(4)
+		before := listNodes(list)
+		assert.PanicsWithValue(t, "test panic", func() {
+			list.Tx(func(tx *Tx[int]) error {
+				tx.PushBack(5)
+				tx.DeleteAt(0)
+				panic("test panic")
+			})
+		})
+		assert.Equal(t, before, listNodes(list))
+		assert.Nil(t, list.recorder)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Rollback of panicking sort", func(t *testing.T) {
+		list, _ := testListInt(10)
+		before := listNodes(list)
+		calls := 0
+		assert.Panics(t, func() {
+			list.Tx(func(tx *Tx[int]) error {
+				tx.Sort(func(v1, v2 int) bool {
+					calls++
+					if calls == 5 {
+						panic("test panic")
+					}
+					return v1 > v2
+				})
+				return nil
+			})
+		})
+		assert.Equal(t, before, listNodes(list))
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Direct changes", func(t *testing.T) {
+		list, _ := testListInt(3)
+		before := listNodes(list)
+		err := list.Tx(func(tx *Tx[int]) error {
+			list.PushBack(4)
+			list.DeleteAt(0)
+			return errTest
+		})
+		assert.Equal(t, errTest, err)
+		assert.Equal(t, before, listNodes(list))
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Nested", func(t *testing.T) {
+		list, _ := testListInt(3)
+		before := listNodes(list)
+		err := list.Tx(func(tx *Tx[int]) error {
+			tx.PushBack(4)
+			assert.Equal(t, errTest, list.Tx(func(tx *Tx[int]) error {
+				tx.PushBack(5)
+				return errTest
+			}))
+			assert.Equal(t, 4, tx.Length())
+			assert.Nil(t, list.Tx(func(tx *Tx[int]) error {
+				tx.PopFront()
+				return nil
+			}))
+			assert.Equal(t, 3, tx.Length())
+			return errTest
+		})
+		assert.Equal(t, errTest, err)
+		assert.Equal(t, before, listNodes(list))
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Arena", func(t *testing.T) {
+		list := NewListWithArena[int](4)
+		first := list.PushBack(1)
+		second := list.PushBack(2)
+
+		err := list.Tx(func(tx *Tx[int]) error {
+			tx.PopFront()
+			tx.PopFront()
+			assert.Nil(t, list.arena.free)
+			return errTest
+		})
+		assert.Equal(t, errTest, err)
+		assert.Equal(t, []*Node[int]{first, second}, listNodes(list))
+		assert.Equal(t, 1, first.Value)
+		assert.Equal(t, 2, second.Value)
+
+		assert.Nil(t, list.Tx(func(tx *Tx[int]) error {
+			tx.PopFront()
+			return nil
+		}))
+		assert.Nil(t, list.arena.free)
+		assert.Equal(t, 1, first.Value)
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Node added to other list", func(t *testing.T) {
+		list, nodes := testListInt(3)
+		other := &List[int]{}
+		err := list.Tx(func(tx *Tx[int]) error {
+			assert.Nil(t, tx.DeleteNode(nodes[1]))
+			assert.Nil(t, other.Append(nodes[1]))
+			return errTest
+		})
+		assert.ErrorIs(t, err, errTest)
+		assert.Equal(t, errors.Join(errTest, &NodeAlreadyInListError[int]{Op: "Tx", Node: nodes[1]}), err)
+		assert.Equal(t, []*Node[int]{nodes[0], nodes[2]}, listNodes(list))
+		assert.Equal(t, []*Node[int]{nodes[1]}, listNodes(other))
+		assert.Nil(t, list.Validate())
+		assert.Nil(t, other.Validate())
+	})
+
+	t.Run("Rollback after SplitAt", func(t *testing.T) {
+		rollbackErr := errors.Join(errTest, &RollbackError{Op: "Tx"})
+		tests := []struct {
+			name string
+			f    func(list *List[int], tx *Tx[int])
+		}{
+			{name: "Move", f: func(list *List[int], tx *Tx[int]) { assert.Nil(t, tx.MoveToFront(list.Tail())) }},
+			{name: "Delete", f: func(list *List[int], tx *Tx[int]) { assert.Nil(t, tx.DeleteNode(list.Tail())) }},
+			{name: "Sort", f: func(list *List[int], tx *Tx[int]) { tx.Sort(func(v1, v2 int) bool { return v1 > v2 }) }},
+		}
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				list := FromValues(1, 2, 3)
+				var other *List[int]
+				err := list.Tx(func(tx *Tx[int]) error {
+					tc.f(list, tx)
+					var err error
+					other, err = list.SplitAt(1)
+					assert.Nil(t, err)
+					return errTest
+				})
+				assert.Equal(t, rollbackErr, err)
+				assert.ErrorIs(t, err, ErrRollback)
+				assert.Equal(t, 1, list.Length())
+				assert.Nil(t, list.Validate())
+				assert.Nil(t, other.Validate())
+			})
+		}
+	})
+
+	t.Run("Rollback after Splice", func(t *testing.T) {
+		list, other := FromValues(1, 2, 3), FromValues(4, 5)
+		err := list.Tx(func(tx *Tx[int]) error {
+			tx.Sort(func(v1, v2 int) bool { return v1 > v2 })
+			assert.Nil(t, list.Splice(nil, other))
+			return errTest
+		})
+		assert.Equal(t, errors.Join(errTest, &RollbackError{Op: "Tx"}), err)
+		assert.Equal(t, []int{4, 5, 3, 2, 1}, list.ToSlice())
+		assert.Nil(t, list.Validate())
+		assert.Nil(t, other.Validate())
+	})
+
+	t.Run("Rollback after MergeSorted", func(t *testing.T) {
+		a, b := FromValues(1, 3), FromValues(2, 4)
+		err := b.Tx(func(tx *Tx[int]) error {
+			assert.Nil(t, tx.Swap(0, 1))
+			assert.Nil(t, tx.Swap(0, 1))
+			MergeSorted(a, b, lessInt)
+			return errTest
+		})
+		assert.Equal(t, errors.Join(errTest, &RollbackError{Op: "Tx"}), err)
+		assert.Equal(t, []int{1, 2, 3, 4}, a.ToSlice())
+		assert.Equal(t, 0, b.Length())
+		assert.Nil(t, a.Validate())
+		assert.Nil(t, b.Validate())
+	})
+
+	t.Run("Rollback after MergeK", func(t *testing.T) {
+		a, b := FromValues(1, 3), FromValues(2, 4)
+		var merged *List[int]
+		err := b.Tx(func(tx *Tx[int]) error {
+			tx.PushBack(5)
+			merged = MergeK([]*List[int]{a, b}, lessInt)
+			return errTest
+		})
+		assert.Equal(t, errors.Join(errTest, &RollbackError{Op: "Tx"}), err)
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, merged.ToSlice())
+		assert.Nil(t, merged.Validate())
+		assert.Nil(t, b.Validate())
+	})
+
+	t.Run("Rollback on panic after SplitAt", func(t *testing.T) {
+		list := FromValues(1, 2, 3)
+		assert.PanicsWithValue(t, "test panic", func() {
+			list.Tx(func(tx *Tx[int]) error {
+				assert.Nil(t, tx.MoveToFront(list.Tail()))
+				list.SplitAt(2)
+				panic("test panic")
+			})
+		})
+		assert.Equal(t, []int{3, 1}, list.ToSlice())
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Node recycled by other list", func(t *testing.T) {
+		list := testListIntArena(3, 4)
+		other, err := list.SplitAt(3)
+		assert.Nil(t, err)
+		node := list.head
+		assert.Nil(t, list.Tx(func(tx *Tx[int]) error {
+			assert.Nil(t, tx.DeleteNode(node))
+			assert.Nil(t, other.Append(node))
+			return other.DeleteNode(node)
+		}))
+
+		// Node deleted in transaction is never recycled, so arena can't hand it out twice.
+		assert.Nil(t, list.arena.free)
+		first, second := list.PushBack(4), other.PushBack(5)
+		assert.NotSame(t, first, second)
+		assert.NotSame(t, node, first)
+		assert.NotSame(t, node, second)
+		assert.Nil(t, list.Validate())
+		assert.Nil(t, other.Validate())
+	})
+}
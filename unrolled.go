@@ -0,0 +1,252 @@
+// Unrolled doubly linked list.
+
+package godll
+
+import (
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+)
+
+// Default number of values stored in one block of unrolled list.
+const defaultBlockSize = 64
+
+// Block of unrolled list holding up to block size values.
+type block[T any] struct {
+	values   []T       // Values in block. Capacity is equal to block size.
+	next     *block[T] // Pointer to next block.
+	previous *block[T] // Pointer to previous block.
+}
+
+// UnrolledList is doubly linked list of blocks, where every block stores multiple values in an array.
+// Storing values next to each other makes traversal cache friendly and lets GetByIndex skip whole blocks.
+// Zero value is an empty list using default block size of 64 values.
+type UnrolledList[T any] struct {
+	head      *block[T] // Pointer to first block in list.
+	tail      *block[T] // Pointer to last block in list.
+	length    int       // Number of values in list.
+	blockSize int       // Maximum number of values in one block.
+}
+
+// NewUnrolledList creates empty UnrolledList storing up to blockSize values in one block.
+// If blockSize is lower than 2, default block size of 64 values is used.
+func NewUnrolledList[T any](blockSize int) *UnrolledList[T] {
+	if blockSize < 2 {
+		blockSize = defaultBlockSize
+	}
+	return &UnrolledList[T]{blockSize: blockSize}
+}
+
+// Length returns number of values in list.
+func (u *UnrolledList[T]) Length() int {
+	return u.length
+}
+
+// Print prints all values in list using passed io.Writer interface.
+func (u *UnrolledList[T]) Print(w io.Writer) {
+	if u.length == 0 {
+		return
+	}
+	separator := ""
+	for v := range u.Values() {
+		fmt.Fprintf(w, "%v%+v", separator, v)
+		separator = " "
+	}
+	fmt.Fprintln(w)
+}
+
+// Values returns iterator over all values, starting from head.
+func (u *UnrolledList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for b := u.head; b != nil; b = b.next {
+			for _, v := range b.values {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Append adds value to the end of the list.
+func (u *UnrolledList[T]) Append(value T) {
+	if u.tail == nil || len(u.tail.values) == u.size() {
+		u.linkAfter(u.newBlock(), u.tail)
+	}
+	u.tail.values = append(u.tail.values, value)
+	u.length++
+}
+
+// Prepend adds value to the beggining of the list.
+func (u *UnrolledList[T]) Prepend(value T) {
+	if u.head == nil || len(u.head.values) == u.size() {
+		u.linkAfter(u.newBlock(), nil)
+	}
+	u.head.values = slices.Insert(u.head.values, 0, value)
+	u.length++
+}
+
+// InsertAt inserts value at specific position.
+func (u *UnrolledList[T]) InsertAt(index int, value T) error {
+	if err := validateInsertableIndex("InsertAt", index, u.length); err != nil {
+		return err
+	}
+
+	if index == u.length {
+		u.Append(value)
+		return nil
+	}
+
+	b, offset := u.find(index)
+
+	// Split full block in half and continue with half which contains index.
+	if len(b.values) == u.size() {
+		half := len(b.values) / 2
+		next := u.newBlock()
+		next.values = append(next.values, b.values[half:]...)
+		clear(b.values[half:])
+		b.values = b.values[:half]
+		u.linkAfter(next, b)
+		if offset >= half {
+			b, offset = next, offset-half
+		}
+	}
+
+	b.values = slices.Insert(b.values, offset, value)
+	u.length++
+	return nil
+}
+
+// GetByIndex retrieves value by index. Return error if index is out of range. Index of first value is 0.
+func (u *UnrolledList[T]) GetByIndex(index int) (T, error) {
+	if err := validateExistingIndex("GetByIndex", index, u.length); err != nil {
+		var zero T
+		return zero, err
+	}
+
+	b, offset := u.find(index)
+	return b.values[offset], nil
+}
+
+// DeleteAt deletes value at given index.
+func (u *UnrolledList[T]) DeleteAt(index int) error {
+	if err := validateExistingIndex("DeleteAt", index, u.length); err != nil {
+		return err
+	}
+
+	b, offset := u.find(index)
+	b.values = slices.Delete(b.values, offset, offset+1)
+	u.length--
+
+	// Remove empty block, or merge block with next one if their values fit into one block.
+	if len(b.values) == 0 {
+		u.unlink(b)
+		return nil
+	}
+	if next := b.next; next != nil && len(b.values)+len(next.values) <= u.size() {
+		b.values = append(b.values, next.values...)
+		u.unlink(next)
+	}
+	return nil
+}
+
+// Sort sorts values in list with sorting function sortFunc. See List.Sort.
+// Values are sorted in a slice and then packed into full blocks.
+func (u *UnrolledList[T]) Sort(sortFunc fun[T]) {
+	if u.length < 2 {
+		return
+	}
+
+	values := make([]T, 0, u.length)
+	for v := range u.Values() {
+		values = append(values, v)
+	}
+	slices.SortStableFunc(values, func(v1, v2 T) int {
+		if sortFunc(v1, v2) {
+			return -1
+		}
+		if sortFunc(v2, v1) {
+			return 1
+		}
+		return 0
+	})
+
+	u.head, u.tail, u.length = nil, nil, 0
+	for _, v := range values {
+		u.Append(v)
+	}
+}
+
+// Return maximum number of values in one block.
+func (u *UnrolledList[T]) size() int {
+	if u.blockSize == 0 {
+		return defaultBlockSize
+	}
+	return u.blockSize
+}
+
+// Create new empty block with capacity of block size.
+func (u *UnrolledList[T]) newBlock() *block[T] {
+	return &block[T]{values: make([]T, 0, u.size())}
+}
+
+// Find block containing value with already validated index and offset of value in that block.
+func (u *UnrolledList[T]) find(index int) (*block[T], int) {
+	// If index is closer to head, start iterating through blocks from head.
+	if index < u.length/2 {
+		b := u.head
+		for index >= len(b.values) {
+			index -= len(b.values)
+			b = b.next
+		}
+		return b, index
+	}
+
+	// If index is closer to tail, start iterating through blocks from tail.
+	b := u.tail
+	fromEnd := u.length - index - 1
+	for fromEnd >= len(b.values) {
+		fromEnd -= len(b.values)
+		b = b.previous
+	}
+	return b, len(b.values) - fromEnd - 1
+}
+
+// Connect block after mark. If mark is nil, block is connected as new head.
+func (u *UnrolledList[T]) linkAfter(b, mark *block[T]) {
+	var next *block[T]
+	if mark == nil {
+		next = u.head
+		u.head = b
+	} else {
+		next = mark.next
+		mark.next = b
+	}
+
+	if next == nil {
+		u.tail = b
+	} else {
+		next.previous = b
+	}
+
+	b.previous = mark
+	b.next = next
+}
+
+// Disconnect block from its neighbours.
+func (u *UnrolledList[T]) unlink(b *block[T]) {
+	if b == u.head {
+		u.head = b.next
+	} else {
+		b.previous.next = b.next
+	}
+
+	if b == u.tail {
+		u.tail = b.previous
+	} else {
+		b.next.previous = b.previous
+	}
+
+	b.next, b.previous = nil, nil
+}
@@ -0,0 +1,365 @@
+package godll
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Create unrolled test list with values from 1 to n.
+func testUnrolledListInt(n, blockSize int) *UnrolledList[int] {
+	list := NewUnrolledList[int](blockSize)
+	for i := 1; i <= n; i++ {
+		list.Append(i)
+	}
+	return list
+}
+
+// Collect values of unrolled list and check that blocks are consistent.
+func unrolledValues[T any](t *testing.T, list *UnrolledList[T]) []T {
+	values, c := []T{}, 0
+	var previous *block[T]
+	for b := list.head; b != nil; b = b.next {
+		assert.Equal(t, previous, b.previous)
+		assert.NotEmpty(t, b.values)
+		assert.LessOrEqual(t, len(b.values), list.size())
+		values = append(values, b.values...)
+		c += len(b.values)
+		previous = b
+	}
+	assert.Equal(t, previous, list.tail)
+	assert.Equal(t, list.length, c)
+	return values
+}
+
+func TestNewUnrolledList(t *testing.T) {
+	assert.Equal(t, 8, NewUnrolledList[int](8).size())
+	assert.Equal(t, defaultBlockSize, NewUnrolledList[int](0).size())
+	assert.Equal(t, defaultBlockSize, (&UnrolledList[int]{}).size())
+}
+
+func TestUnrolledAppendPrepend(t *testing.T) {
+	t.Run("Append", func(t *testing.T) {
+		list := testUnrolledListInt(10, 4)
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, unrolledValues(t, list))
+		assert.Equal(t, 10, list.Length())
+	})
+
+	t.Run("Prepend", func(t *testing.T) {
+		list := NewUnrolledList[int](4)
+		for i := 1; i <= 6; i++ {
+			list.Prepend(i)
+		}
+		assert.Equal(t, []int{6, 5, 4, 3, 2, 1}, unrolledValues(t, list))
+	})
+
+	t.Run("Zero value", func(t *testing.T) {
+		list := &UnrolledList[string]{}
+		list.Append("b")
+		list.Prepend("a")
+		assert.Equal(t, []string{"a", "b"}, unrolledValues(t, list))
+	})
+}
+
+func TestUnrolledInsertAt(t *testing.T) {
+	testCases := []struct {
+		name     string
+		index    int
+		expected []int
+	}{
+		{name: "Beginning", index: 0, expected: []int{0, 1, 2, 3, 4, 5}},
+		{name: "Split first half", index: 1, expected: []int{1, 0, 2, 3, 4, 5}},
+		{name: "Split second half", index: 3, expected: []int{1, 2, 3, 0, 4, 5}},
+		{name: "Second block", index: 4, expected: []int{1, 2, 3, 4, 0, 5}},
+		{name: "End", index: 5, expected: []int{1, 2, 3, 4, 5, 0}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			list := testUnrolledListInt(5, 4)
+			err := list.InsertAt(tc.index, 0)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, unrolledValues(t, list))
+		})
+	}
+
+	t.Run("Out of range", func(t *testing.T) {
+		list := testUnrolledListInt(3, 4)
+		err := list.InsertAt(4, 0)
+		assert.Equal(t, &IndexOutOfRangeError{Op: "InsertAt", Index: 4, Length: 3}, err)
+		err = list.InsertAt(-1, 0)
+		assert.Equal(t, &NegativeIndexError{Op: "InsertAt", Index: -1}, err)
+		assert.Equal(t, 3, list.Length())
+	})
+}
+
+func TestUnrolledGetByIndex(t *testing.T) {
+	t.Run("Existing", func(t *testing.T) {
+		list := testUnrolledListInt(11, 3)
+		for i := 0; i < 11; i++ {
+			v, err := list.GetByIndex(i)
+			assert.Nil(t, err)
+			assert.Equal(t, i+1, v)
+		}
+	})
+
+	t.Run("Out of range", func(t *testing.T) {
+		list := testUnrolledListInt(3, 4)
+		v, err := list.GetByIndex(3)
+		assert.Equal(t, &IndexOutOfRangeError{Op: "GetByIndex", Index: 3, Length: 3}, err)
+		assert.Equal(t, 0, v)
+		_, err = list.GetByIndex(-1)
+		assert.Equal(t, &NegativeIndexError{Op: "GetByIndex", Index: -1}, err)
+	})
+}
+
+func TestUnrolledDeleteAt(t *testing.T) {
+	t.Run("Empty block", func(t *testing.T) {
+		list := testUnrolledListInt(5, 4)
+		err := list.DeleteAt(4)
+		assert.Nil(t, err)
+		assert.Equal(t, []int{1, 2, 3, 4}, unrolledValues(t, list))
+		assert.Equal(t, list.head, list.tail)
+	})
+
+	t.Run("Merge blocks", func(t *testing.T) {
+		list := testUnrolledListInt(6, 4)
+		for i := 0; i < 3; i++ {
+			err := list.DeleteAt(0)
+			assert.Nil(t, err)
+		}
+		assert.Equal(t, []int{4, 5, 6}, unrolledValues(t, list))
+		assert.Equal(t, list.head, list.tail)
+	})
+
+	t.Run("All", func(t *testing.T) {
+		list := testUnrolledListInt(9, 4)
+		for i := 0; i < 9; i++ {
+			err := list.DeleteAt(list.Length() / 2)
+			assert.Nil(t, err)
+		}
+		assert.Equal(t, []int{}, unrolledValues(t, list))
+		assert.Nil(t, list.head)
+		assert.Nil(t, list.tail)
+	})
+
+	t.Run("Out of range", func(t *testing.T) {
+		list := &UnrolledList[int]{}
+		err := list.DeleteAt(0)
+		assert.Equal(t, &IndexOutOfRangeError{Op: "DeleteAt", Index: 0, Length: 0}, err)
+	})
+}
+
+func TestUnrolledSort(t *testing.T) {
+	t.Run("Random", func(t *testing.T) {
+		list := NewUnrolledList[int](5)
+		for _, v := range rand.Perm(50) {
+			list.Append(v)
+		}
+		list.Sort(func(v1, v2 int) bool { return v1 < v2 })
+		values := unrolledValues(t, list)
+		for i, v := range values {
+			assert.Equal(t, i, v)
+		}
+		assert.Equal(t, 50, len(values))
+	})
+
+	t.Run("Stable", func(t *testing.T) {
+		list := NewUnrolledList[PersonTest](2)
+		list.Append(PersonTest{ID: 2, FirstName: "Bruce"})
+		list.Append(PersonTest{ID: 1, FirstName: "Clark"})
+		list.Append(PersonTest{ID: 2, FirstName: "Diana"})
+		list.Append(PersonTest{ID: 1, FirstName: "Barry"})
+		list.Sort(func(v1, v2 PersonTest) bool { return v1.ID < v2.ID })
+		names := []string{}
+		for _, v := range unrolledValues(t, list) {
+			names = append(names, v.FirstName)
+		}
+		assert.Equal(t, []string{"Clark", "Barry", "Bruce", "Diana"}, names)
+	})
+}
+
+func TestUnrolledPrint(t *testing.T) {
+	var output bytes.Buffer
+	list := &UnrolledList[int]{}
+	list.Print(&output)
+	assert.Equal(t, "", output.String())
+
+	list = testUnrolledListInt(5, 2)
+	list.Print(&output)
+	assert.Equal(t, "1 2 3 4 5\n", output.String())
+}
+
+func TestUnrolledRandomOperations(t *testing.T) {
+	list, expected := NewUnrolledList[int](4), []int{}
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 2000; i++ {
+		switch op := r.Intn(4); {
+		case op == 0:
+			list.Append(i)
+			expected = append(expected, i)
+		case op == 1:
+			list.Prepend(i)
+			expected = append([]int{i}, expected...)
+		case op == 2:
+			index := r.Intn(len(expected) + 1)
+			assert.Nil(t, list.InsertAt(index, i))
+			expected = append(expected[:index], append([]int{i}, expected[index:]...)...)
+		case len(expected) > 0:
+			index := r.Intn(len(expected))
+			assert.Nil(t, list.DeleteAt(index))
+			expected = append(expected[:index], expected[index+1:]...)
+		}
+	}
+	assert.Equal(t, expected, unrolledValues(t, list))
+}
+
+func BenchmarkUnrolledAppend(b *testing.B) {
+	for _, tc := range benchmarkTestCases {
+		b.Run(tc.name, func(b *testing.B) {
+			b.Run("List", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					list := &List[int]{}
+					for j := 0; j < tc.n; j++ {
+						list.PushBack(j)
+					}
+				}
+			})
+
+			b.Run("UnrolledList", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					list := &UnrolledList[int]{}
+					for j := 0; j < tc.n; j++ {
+						list.Append(j)
+					}
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkUnrolledPrepend(b *testing.B) {
+	for _, tc := range benchmarkTestCases {
+		b.Run(tc.name, func(b *testing.B) {
+			b.Run("List", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					list := &List[int]{}
+					for j := 0; j < tc.n; j++ {
+						list.PushFront(j)
+					}
+				}
+			})
+
+			b.Run("UnrolledList", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					list := &UnrolledList[int]{}
+					for j := 0; j < tc.n; j++ {
+						list.Prepend(j)
+					}
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkUnrolledInsertAt(b *testing.B) {
+	for _, tc := range benchmarkTestCases {
+		b.Run(tc.name, func(b *testing.B) {
+			b.Run("List", func(b *testing.B) {
+				list, _ := testListInt(tc.n)
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					err := list.InsertAt(tc.n/2, NewNode(i))
+					assert.Nil(b, err)
+				}
+			})
+
+			b.Run("UnrolledList", func(b *testing.B) {
+				list := testUnrolledListInt(tc.n, defaultBlockSize)
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					err := list.InsertAt(tc.n/2, i)
+					assert.Nil(b, err)
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkUnrolledDeleteAt(b *testing.B) {
+	for _, tc := range benchmarkTestCases {
+		b.Run(tc.name, func(b *testing.B) {
+			b.Run("List", func(b *testing.B) {
+				list, _ := testListInt(tc.n)
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					err := list.DeleteAt(tc.n / 2)
+					assert.Nil(b, err)
+					list.PushBack(i)
+				}
+			})
+
+			b.Run("UnrolledList", func(b *testing.B) {
+				list := testUnrolledListInt(tc.n, defaultBlockSize)
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					err := list.DeleteAt(tc.n / 2)
+					assert.Nil(b, err)
+					list.Append(i)
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkUnrolledGetByIndex(b *testing.B) {
+	for _, tc := range benchmarkTestCases {
+		b.Run(tc.name, func(b *testing.B) {
+			b.Run("List", func(b *testing.B) {
+				list, _ := testListInt(tc.n)
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					_, err := list.GetByIndex(tc.n / 2)
+					assert.Nil(b, err)
+				}
+			})
+
+			b.Run("UnrolledList", func(b *testing.B) {
+				list := testUnrolledListInt(tc.n, defaultBlockSize)
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					_, err := list.GetByIndex(tc.n / 2)
+					assert.Nil(b, err)
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkUnrolledSort(b *testing.B) {
+	for _, tc := range benchmarkTestCases {
+		b.Run(tc.name, func(b *testing.B) {
+			b.Run("List", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					b.StopTimer()
+					list := generateRandomList(tc.n)
+					b.StartTimer()
+					list.Sort(func(v1, v2 int) bool { return v1 < v2 })
+				}
+			})
+
+			b.Run("UnrolledList", func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					b.StopTimer()
+					list := &UnrolledList[int]{}
+					for _, v := range rand.Perm(tc.n) {
+						list.Append(v)
+					}
+					b.StartTimer()
+					list.Sort(func(v1, v2 int) bool { return v1 < v2 })
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,70 @@
+// Structural validation of doubly linked list.
+
+package godll
+
+import "fmt"
+
+// Validate checks structural invariants of List. It walks list from head using next links and from tail using previous links,
+// checking that links are symmetric, that there are no cycles, that head and tail are ends of the list,
+// that all nodes belong to List and that number of nodes is equal to length. Return InvalidListError describing first broken invariant.
+func (l *List[T]) Validate() error {
+	if l.length < 0 {
+		return &InvalidListError{Reason: fmt.Sprintf("negative length %v", l.length)}
+	}
+
+	// Empty list must have both head and tail set to nil.
+	if l.head == nil || l.tail == nil {
+		if l.head != nil || l.tail != nil {
+			return &InvalidListError{Reason: "only one of head and tail is nil"}
+		}
+		if l.length != 0 {
+			return &InvalidListError{Reason: fmt.Sprintf("no nodes, but length is %v", l.length)}
+		}
+		return nil
+	}
+
+	if l.head.previous != nil {
+		return &InvalidListError{Reason: "head has previous node"}
+	}
+	if l.tail.next != nil {
+		return &InvalidListError{Reason: "tail has next node"}
+	}
+
+	// Walk forward from head. Walking is stopped after length nodes, so cycle can't make it endless.
+	c := 0
+	for current := l.head; current != nil; current = current.next {
+		if c == l.length {
+			return &InvalidListError{Reason: fmt.Sprintf("found more than %v nodes walking from head, list has cycle or wrong length", l.length)}
+		}
+		if current.list != l {
+			return &InvalidListError{Reason: fmt.Sprintf("node at index %v doesn't belong to list", c)}
+		}
+		if current.next != nil && current.next.previous != current {
+			return &InvalidListError{Reason: fmt.Sprintf("next of node at index %v doesn't link back to it", c)}
+		}
+		if current.next == nil && current != l.tail {
+			return &InvalidListError{Reason: fmt.Sprintf("walking from head ended at index %v before reaching tail", c)}
+		}
+		c++
+	}
+	if c != l.length {
+		return &InvalidListError{Reason: fmt.Sprintf("found %v nodes walking from head, but length is %v", c, l.length)}
+	}
+
+	// Walk backward from tail to make sure that previous links lead from tail to head.
+	c = 0
+	for current := l.tail; current != nil; current = current.previous {
+		if c == l.length {
+			return &InvalidListError{Reason: fmt.Sprintf("found more than %v nodes walking from tail, list has cycle or wrong length", l.length)}
+		}
+		if current.previous == nil && current != l.head {
+			return &InvalidListError{Reason: "walking from tail ended before reaching head"}
+		}
+		c++
+	}
+	if c != l.length {
+		return &InvalidListError{Reason: fmt.Sprintf("found %v nodes walking from tail, but length is %v", c, l.length)}
+	}
+
+	return nil
+}
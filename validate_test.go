@@ -0,0 +1,90 @@
+package godll
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	t.Run("Empty list", func(t *testing.T) {
+		list := &List[int]{}
+		assert.Nil(t, list.Validate())
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		for _, n := range []int{1, 2, 5} {
+			list, _ := testListInt(n)
+			assert.Nil(t, list.Validate())
+		}
+	})
+
+	testCases := []struct {
+		name    string
+		corrupt func(list *List[int], nodes []*Node[int])
+		reason  string
+	}{
+		{
+			name:    "Negative length",
+			corrupt: func(list *List[int], nodes []*Node[int]) { list.length = -1 },
+			reason:  "negative length -1",
+		},
+		{
+			name:    "Nil tail",
+			corrupt: func(list *List[int], nodes []*Node[int]) { list.tail = nil },
+			reason:  "only one of head and tail is nil",
+		},
+		{
+			name:    "Wrong length",
+			corrupt: func(list *List[int], nodes []*Node[int]) { list.length = 3 },
+			reason:  "found more than 3 nodes walking from head, list has cycle or wrong length",
+		},
+		{
+			name:    "Too large length",
+			corrupt: func(list *List[int], nodes []*Node[int]) { list.length = 5 },
+			reason:  "found 4 nodes walking from head, but length is 5",
+		},
+		{
+			name:    "Head with previous",
+			corrupt: func(list *List[int], nodes []*Node[int]) { nodes[0].previous = nodes[3] },
+			reason:  "head has previous node",
+		},
+		{
+			name:    "Tail with next",
+			corrupt: func(list *List[int], nodes []*Node[int]) { nodes[3].next = nodes[0] },
+			reason:  "tail has next node",
+		},
+		{
+			name:    "Broken previous link",
+			corrupt: func(list *List[int], nodes []*Node[int]) { nodes[2].previous = nodes[0] },
+			reason:  "next of node at index 1 doesn't link back to it",
+		},
+		{
+			name: "Cycle",
+			corrupt: func(list *List[int], nodes []*Node[int]) {
+				nodes[2].next = nodes[1]
+				nodes[1].previous = nodes[2]
+			},
+			reason: "next of node at index 0 doesn't link back to it",
+		},
+		{
+			name:    "Foreign node",
+			corrupt: func(list *List[int], nodes []*Node[int]) { nodes[1].list = nil },
+			reason:  "node at index 1 doesn't belong to list",
+		},
+		{
+			name: "Wrong tail",
+			corrupt: func(list *List[int], nodes []*Node[int]) {
+				nodes[2].next = nil
+			},
+			reason: "walking from head ended at index 2 before reaching tail",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			list, nodes := testListInt(4)
+			tc.corrupt(list, nodes)
+			assert.Equal(t, &InvalidListError{Reason: tc.reason}, list.Validate())
+		})
+	}
+}